@@ -170,3 +170,62 @@ func TestSmithyJSONValueInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestSmithyJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.SmithyJSON[smithyjson.JSONStringer]
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			val1:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": "b"}`, newTestJSONDocument),
+			val2:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": "b"}`, newTestJSONDocument),
+			equals: true,
+		},
+		"whitespace differences": {
+			val1: fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a":"b","c":["d","e"]}`, newTestJSONDocument),
+			val2: fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`
+{
+  "a": "b",
+  "c": [
+    "d",
+    "e"
+  ]
+}
+`, newTestJSONDocument),
+			equals: true,
+		},
+		"reordered keys": {
+			val1:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": "b", "c": {"d": 1, "e": 2}}`, newTestJSONDocument),
+			val2:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"c": {"e": 2, "d": 1}, "a": "b"}`, newTestJSONDocument),
+			equals: true,
+		},
+		"reordered list elements": {
+			val1:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": ["b", "c"]}`, newTestJSONDocument),
+			val2:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": ["c", "b"]}`, newTestJSONDocument),
+			equals: false,
+		},
+		"different values": {
+			val1:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": "b"}`, newTestJSONDocument),
+			val2:   fwtypes.SmithyJSONValue[smithyjson.JSONStringer](`{"a": "c"}`, newTestJSONDocument),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
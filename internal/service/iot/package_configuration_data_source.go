@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iot_package_configuration", name="Package Configuration")
+func DataSourcePackageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePackageConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"version_update_by_jobs_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrRoleARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePackageConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	input := &iot.GetPackageConfigurationInput{}
+
+	output, err := conn.GetPackageConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Package Configuration: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("version_update_by_jobs_config", flattenVersionUpdateByJobsConfig(output.VersionUpdateByJobsConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting version_update_by_jobs_config: %s", err)
+	}
+
+	return diags
+}
+
+func flattenVersionUpdateByJobsConfig(apiObject *iot.VersionUpdateByJobsConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+		names.AttrRoleARN: aws.StringValue(apiObject.RoleArn),
+	}
+
+	return []interface{}{tfMap}
+}
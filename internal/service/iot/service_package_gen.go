@@ -30,6 +30,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceEndpoint,
 			TypeName: "aws_iot_endpoint",
 		},
+		{
+			Factory:  DataSourcePackageConfiguration,
+			TypeName: "aws_iot_package_configuration",
+			Name:     "Package Configuration",
+		},
 		{
 			Factory:  DataSourceRegistrationCode,
 			TypeName: "aws_iot_registration_code",
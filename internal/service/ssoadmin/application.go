@@ -6,10 +6,12 @@ package ssoadmin
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -36,7 +38,11 @@ import (
 // @FrameworkResource(name="Application")
 // @Tags
 func newResourceApplication(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceApplication{}, nil
+	r := &resourceApplication{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -45,6 +51,7 @@ const (
 
 type resourceApplication struct {
 	framework.ResourceWithConfigure
+	framework.WithTimeouts
 }
 
 func (r *resourceApplication) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -99,6 +106,9 @@ func (r *resourceApplication) Schema(ctx context.Context, req resource.SchemaReq
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
 		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 			"portal_options": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -189,7 +199,7 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.Name.String(), err),
-			err.Error(),
+			createErrorDetail(err, "applications"),
 		)
 		return
 	}
@@ -204,9 +214,18 @@ func (r *resourceApplication) Create(ctx context.Context, req resource.CreateReq
 	plan.ApplicationARN = flex.StringToFrameworkARN(ctx, out.ApplicationArn)
 	plan.ID = flex.StringToFramework(ctx, out.ApplicationArn)
 
-	// Read after create to get computed attributes omitted from the create response
-	readOut, err := findApplicationByID(ctx, conn, plan.ID.ValueString())
+	// Wait for the application to become available with the requested status before
+	// returning, so dependent assignments and grants don't race its creation.
+	// This also populates computed attributes omitted from the create response.
+	// When no status is configured, any status is accepted.
+	target := enum.Values[awstypes.ApplicationStatus]()
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() {
+		target = []string{plan.Status.ValueString()}
+	}
+
+	readOut, err := waitApplicationCreated(ctx, conn, plan.ID.ValueString(), target, r.CreateTimeout(ctx, plan.Timeouts))
 	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), plan.ID) // Set 'id' so as to taint the resource.
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameApplication, plan.ID.String(), err),
 			err.Error(),
@@ -404,6 +423,40 @@ func findApplicationByID(ctx context.Context, conn *ssoadmin.Client, id string)
 	return out, nil
 }
 
+func statusApplication(ctx context.Context, conn *ssoadmin.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitApplicationCreated(ctx context.Context, conn *ssoadmin.Client, id string, target []string, timeout time.Duration) (*ssoadmin.DescribeApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    target,
+		Refresh:                   statusApplication(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssoadmin.DescribeApplicationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func flattenPortalOptions(ctx context.Context, apiObject *awstypes.PortalOptions) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	elemType := types.ObjectType{AttrTypes: portalOptionsAttrTypes}
@@ -511,18 +564,19 @@ func expandSignInOptions(tfList []signInOptionsData) *awstypes.SignInOptions {
 }
 
 type resourceApplicationData struct {
-	ApplicationAccount     types.String `tfsdk:"application_account"`
-	ApplicationARN         fwtypes.ARN  `tfsdk:"application_arn"`
-	ApplicationProviderARN fwtypes.ARN  `tfsdk:"application_provider_arn"`
-	ClientToken            types.String `tfsdk:"client_token"`
-	Description            types.String `tfsdk:"description"`
-	ID                     types.String `tfsdk:"id"`
-	InstanceARN            fwtypes.ARN  `tfsdk:"instance_arn"`
-	Name                   types.String `tfsdk:"name"`
-	PortalOptions          types.List   `tfsdk:"portal_options"`
-	Status                 types.String `tfsdk:"status"`
-	Tags                   types.Map    `tfsdk:"tags"`
-	TagsAll                types.Map    `tfsdk:"tags_all"`
+	ApplicationAccount     types.String   `tfsdk:"application_account"`
+	ApplicationARN         fwtypes.ARN    `tfsdk:"application_arn"`
+	ApplicationProviderARN fwtypes.ARN    `tfsdk:"application_provider_arn"`
+	ClientToken            types.String   `tfsdk:"client_token"`
+	Description            types.String   `tfsdk:"description"`
+	ID                     types.String   `tfsdk:"id"`
+	InstanceARN            fwtypes.ARN    `tfsdk:"instance_arn"`
+	Name                   types.String   `tfsdk:"name"`
+	PortalOptions          types.List     `tfsdk:"portal_options"`
+	Status                 types.String   `tfsdk:"status"`
+	Tags                   types.Map      `tfsdk:"tags"`
+	TagsAll                types.Map      `tfsdk:"tags_all"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type portalOptionsData struct {
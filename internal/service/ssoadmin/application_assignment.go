@@ -5,6 +5,9 @@ package ssoadmin
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
@@ -54,6 +57,9 @@ func (r *resourceApplicationAssignment) Schema(ctx context.Context, req resource
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					applicationARN(),
+				},
 			},
 			names.AttrID: framework.IDAttribute(),
 			"principal_id": schema.StringAttribute{
@@ -175,7 +181,31 @@ func (r *resourceApplicationAssignment) Delete(ctx context.Context, req resource
 }
 
 func (r *resourceApplicationAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	parts, err := intflex.ExpandResourceId(req.ID, applicationAssignmentIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Resource Import Invalid ID",
+			fmt.Sprintf(`Unexpected format for import ID (%s), use: "application_arn,principal_id,principal_type"`, req.ID),
+		)
+		return
+	}
+
+	applicationARN, principalID, principalType := parts[0], parts[1], parts[2]
+
+	if !slices.Contains(enum.Values[awstypes.PrincipalType](), principalType) {
+		resp.Diagnostics.AddError(
+			"Resource Import Invalid ID",
+			fmt.Sprintf("Invalid principal_type (%s) in import ID (%s), must be one of: %s", principalType, req.ID, strings.Join(enum.Values[awstypes.PrincipalType](), ", ")),
+		)
+		return
+	}
+
+	id, _ := intflex.FlattenResourceId([]string{applicationARN, principalID, principalType}, applicationAssignmentIDPartCount, false)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_arn"), applicationARN)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_id"), principalID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), principalType)...)
 }
 
 func findApplicationAssignmentByID(ctx context.Context, conn *ssoadmin.Client, id string) (*ssoadmin.DescribeApplicationAssignmentOutput, error) {
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Application Assignment Configuration")
+func newDataSourceApplicationAssignmentConfiguration(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceApplicationAssignmentConfiguration{}, nil
+}
+
+const (
+	DSNameApplicationAssignmentConfiguration = "Application Assignment Configuration Data Source"
+)
+
+type dataSourceApplicationAssignmentConfiguration struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceApplicationAssignmentConfiguration) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_ssoadmin_application_assignment_configuration"
+}
+
+func (d *dataSourceApplicationAssignmentConfiguration) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				Validators: []validator.String{
+					applicationARN(),
+				},
+			},
+			"assignment_required": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceApplicationAssignmentConfiguration) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().SSOAdminClient(ctx)
+
+	var data dataSourceApplicationAssignmentConfigurationData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findApplicationAssignmentConfigurationByID(ctx, conn, data.ApplicationARN.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionReading, DSNameApplicationAssignmentConfiguration, data.ApplicationARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AssignmentRequired = flex.BoolToFramework(ctx, out.AssignmentRequired)
+	data.ID = types.StringValue(data.ApplicationARN.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceApplicationAssignmentConfigurationData struct {
+	ApplicationARN     fwtypes.ARN  `tfsdk:"application_arn"`
+	AssignmentRequired types.Bool   `tfsdk:"assignment_required"`
+	ID                 types.String `tfsdk:"id"`
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminApplicationAssignmentConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssoadmin_application_assignment_configuration.test"
+	resourceName := "aws_ssoadmin_application_assignment_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAssignmentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAssignmentConfigurationDataSourceConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_arn", resourceName, "application_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "assignment_required", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationAssignmentConfigurationDataSourceConfig_basic(rName string, assignmentRequired bool) string {
+	return acctest.ConfigCompose(
+		testAccApplicationAssignmentConfigurationConfig_basic(rName, assignmentRequired),
+		`
+data "aws_ssoadmin_application_assignment_configuration" "test" {
+  application_arn = aws_ssoadmin_application_assignment_configuration.test.application_arn
+}
+`)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -127,6 +128,30 @@ func TestAccSSOAdminApplicationAssignmentConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplicationAssignmentConfiguration_invalidApplicationARN(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAssignmentConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationAssignmentConfigurationConfig_applicationARN("arn:aws:sso:::instance/ssoins-1111111111111111"), //lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`got an instance ARN`),
+			},
+			{
+				Config:      testAccApplicationAssignmentConfigurationConfig_applicationARN("not-an-arn"),
+				ExpectError: regexache.MustCompile(`must be an IAM Identity Center application ARN`),
+			},
+		},
+	})
+}
+
 func testAccCheckApplicationAssignmentConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
@@ -189,3 +214,12 @@ resource "aws_ssoadmin_application_assignment_configuration" "test" {
 }
 `, rName, testAccApplicationProviderARN, assignmentRequired)
 }
+
+func testAccApplicationAssignmentConfigurationConfig_applicationARN(applicationARN string) string {
+	return fmt.Sprintf(`
+resource "aws_ssoadmin_application_assignment_configuration" "test" {
+  application_arn     = %[1]q
+  assignment_required = true
+}
+`, applicationARN)
+}
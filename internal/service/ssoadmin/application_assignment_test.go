@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -52,6 +53,12 @@ func TestAccSSOAdminApplicationAssignment_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "arn:aws:sso::123456789012:application/ssoins-1234567890abcdef/apl-1234567890abcdef,abcd1234,ROBOT", //lintignore:AWSAT005
+				ExpectError:   regexache.MustCompile(`Invalid principal_type \(ROBOT\)`),
+			},
 		},
 	})
 }
@@ -146,6 +153,30 @@ func TestAccSSOAdminApplicationAssignment_disappears_Application(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminApplicationAssignment_invalidApplicationARN(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAssignmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationAssignmentConfig_applicationARN("arn:aws:sso:::instance/ssoins-1111111111111111"), //lintignore:AWSAT005
+				ExpectError: regexache.MustCompile(`got an instance ARN`),
+			},
+			{
+				Config:      testAccApplicationAssignmentConfig_applicationARN("not-an-arn"),
+				ExpectError: regexache.MustCompile(`must be an IAM Identity Center application ARN`),
+			},
+		},
+	})
+}
+
 func testAccCheckApplicationAssignmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
@@ -244,3 +275,13 @@ resource "aws_ssoadmin_application_assignment" "test" {
 }
 `, rName))
 }
+
+func testAccApplicationAssignmentConfig_applicationARN(applicationARN string) string {
+	return fmt.Sprintf(`
+resource "aws_ssoadmin_application_assignment" "test" {
+  application_arn = %[1]q
+  principal_id    = "1111111111-11111111-1111-1111-1111-111111111111"
+  principal_type  = "USER"
+}
+`, applicationARN)
+}
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -41,6 +42,9 @@ func (d *dataSourceApplicationAssignments) Schema(ctx context.Context, req datas
 		Attributes: map[string]schema.Attribute{
 			"application_arn": schema.StringAttribute{
 				Required: true,
+				Validators: []validator.String{
+					applicationARN(),
+				},
 			},
 			names.AttrID: framework.IDAttribute(),
 		},
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Application Authentication Methods")
+func newDataSourceApplicationAuthenticationMethods(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceApplicationAuthenticationMethods{}, nil
+}
+
+const (
+	DSNameApplicationAuthenticationMethods = "Application Authentication Methods Data Source"
+)
+
+type dataSourceApplicationAuthenticationMethods struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceApplicationAuthenticationMethods) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_ssoadmin_application_authentication_methods"
+}
+
+func (d *dataSourceApplicationAuthenticationMethods) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				Validators: []validator.String{
+					applicationARN(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"authentication_methods": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"authentication_method_type": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"authentication_method": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"iam": schema.ListNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"actor_policy": schema.StringAttribute{
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceApplicationAuthenticationMethods) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().SSOAdminClient(ctx)
+
+	var data dataSourceApplicationAuthenticationMethodsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paginator := ssoadmin.NewListApplicationAuthenticationMethodsPaginator(conn, &ssoadmin.ListApplicationAuthenticationMethodsInput{
+		ApplicationArn: aws.String(data.ApplicationARN.ValueString()),
+	})
+
+	var apiObjects []awstypes.AuthenticationMethodItem
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionReading, DSNameApplicationAuthenticationMethods, data.ApplicationARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if page != nil {
+			apiObjects = append(apiObjects, page.AuthenticationMethods...)
+		}
+	}
+
+	data.ID = types.StringValue(data.ApplicationARN.ValueString())
+
+	authenticationMethods, diags := flattenAuthenticationMethodItems(ctx, apiObjects)
+	resp.Diagnostics.Append(diags...)
+	data.AuthenticationMethods = authenticationMethods
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceApplicationAuthenticationMethodsData struct {
+	ApplicationARN        fwtypes.ARN  `tfsdk:"application_arn"`
+	AuthenticationMethods types.List   `tfsdk:"authentication_methods"`
+	ID                    types.String `tfsdk:"id"`
+}
+
+var authenticationMethodItemAttrTypes = map[string]attr.Type{
+	"authentication_method":      types.ListType{ElemType: types.ObjectType{AttrTypes: authenticationMethodAttrTypes}},
+	"authentication_method_type": types.StringType,
+}
+
+var authenticationMethodAttrTypes = map[string]attr.Type{
+	"iam": types.ListType{ElemType: types.ObjectType{AttrTypes: iamAuthenticationMethodAttrTypes}},
+}
+
+var iamAuthenticationMethodAttrTypes = map[string]attr.Type{
+	"actor_policy": types.StringType,
+}
+
+func flattenAuthenticationMethodItems(ctx context.Context, apiObjects []awstypes.AuthenticationMethodItem) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: authenticationMethodItemAttrTypes}
+
+	if len(apiObjects) == 0 {
+		return types.ListNull(elemType), diags
+	}
+
+	elems := []attr.Value{}
+	for _, apiObject := range apiObjects {
+		authenticationMethod, d := flattenAuthenticationMethod(ctx, apiObject.AuthenticationMethod)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"authentication_method":      authenticationMethod,
+			"authentication_method_type": flex.StringValueToFramework(ctx, apiObject.AuthenticationMethodType),
+		}
+		objVal, d := types.ObjectValue(authenticationMethodItemAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenAuthenticationMethod(ctx context.Context, apiObject awstypes.AuthenticationMethod) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: authenticationMethodAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType), diags
+	}
+
+	iam := types.ListNull(types.ObjectType{AttrTypes: iamAuthenticationMethodAttrTypes})
+
+	switch v := apiObject.(type) {
+	case *awstypes.AuthenticationMethodMemberIam:
+		var d diag.Diagnostics
+		iam, d = flattenIAMAuthenticationMethod(ctx, &v.Value)
+		diags.Append(d...)
+	default:
+		// Unknown union members are left unset.
+	}
+
+	obj := map[string]attr.Value{
+		"iam": iam,
+	}
+	objVal, d := types.ObjectValue(authenticationMethodAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenIAMAuthenticationMethod(ctx context.Context, apiObject *awstypes.IamAuthenticationMethod) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: iamAuthenticationMethodAttrTypes}
+
+	if apiObject == nil {
+		return types.ListNull(elemType), diags
+	}
+
+	actorPolicy := types.StringNull()
+	if apiObject.ActorPolicy != nil {
+		v, err := json.SmithyDocumentToString(apiObject.ActorPolicy)
+		if err != nil {
+			diags.AddError("reading actor policy", err.Error())
+			return types.ListNull(elemType), diags
+		}
+
+		actorPolicy = flex.StringValueToFramework(ctx, v)
+	}
+
+	obj := map[string]attr.Value{
+		"actor_policy": actorPolicy,
+	}
+	objVal, d := types.ObjectValue(iamAuthenticationMethodAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminApplicationAuthenticationMethodsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssoadmin_application_authentication_methods.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAuthenticationMethodsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_arn", applicationResourceName, "application_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "authentication_methods.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenAuthenticationMethodItems(t *testing.T) {
+	t.Parallel()
+
+	type iamAuthenticationMethod struct {
+		ActorPolicy types.String `tfsdk:"actor_policy"`
+	}
+	type authenticationMethod struct {
+		IAM []iamAuthenticationMethod `tfsdk:"iam"`
+	}
+	type authenticationMethodItem struct {
+		AuthenticationMethod     []authenticationMethod `tfsdk:"authentication_method"`
+		AuthenticationMethodType types.String           `tfsdk:"authentication_method_type"`
+	}
+
+	ctx := context.Background()
+	apiObjects := []awstypes.AuthenticationMethodItem{
+		{
+			AuthenticationMethod: &awstypes.AuthenticationMethodMemberIam{
+				Value: awstypes.IamAuthenticationMethod{
+					ActorPolicy: document.NewLazyDocument(map[string]interface{}{
+						"Version": "2012-10-17",
+						"Statement": []interface{}{
+							map[string]interface{}{
+								"Effect":    "Allow",
+								"Action":    "sso-oauth:CreateTokenWithIAM",
+								"Principal": "*",
+								"Resource":  "*",
+							},
+						},
+					}),
+				},
+			},
+			AuthenticationMethodType: awstypes.AuthenticationMethodTypeIam,
+		},
+	}
+
+	list, diags := tfssoadmin.FlattenAuthenticationMethodItems(ctx, apiObjects)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var items []authenticationMethodItem
+	if diags := list.ElementsAs(ctx, &items, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := len(items), 1; got != want {
+		t.Fatalf("authentication_methods length = %d, want %d", got, want)
+	}
+	if got, want := items[0].AuthenticationMethodType.ValueString(), string(awstypes.AuthenticationMethodTypeIam); got != want {
+		t.Errorf("authentication_method_type = %q, want %q", got, want)
+	}
+	if got, want := len(items[0].AuthenticationMethod), 1; got != want {
+		t.Fatalf("authentication_method length = %d, want %d", got, want)
+	}
+	if got, want := len(items[0].AuthenticationMethod[0].IAM), 1; got != want {
+		t.Fatalf("iam length = %d, want %d", got, want)
+	}
+
+	want := `{"Statement":[{"Action":"sso-oauth:CreateTokenWithIAM","Effect":"Allow","Principal":"*","Resource":"*"}],"Version":"2012-10-17"}`
+	if got := items[0].AuthenticationMethod[0].IAM[0].ActorPolicy.ValueString(); got != want {
+		t.Errorf("actor_policy = %s, want %s", got, want)
+	}
+}
+
+func testAccApplicationAuthenticationMethodsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_basic(rName, testAccApplicationProviderARN),
+		`
+data "aws_ssoadmin_application_authentication_methods" "test" {
+  application_arn = aws_ssoadmin_application.test.application_arn
+}
+`)
+}
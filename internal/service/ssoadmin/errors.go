@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// createErrorDetail returns the diagnostic detail for a failed create.
+// When the failure is due to a service quota it adds guidance on how to resolve it.
+func createErrorDetail(err error, quota string) string {
+	if errs.IsA[*awstypes.ServiceQuotaExceededException](err) {
+		return fmt.Sprintf("%s\n\nThe IAM Identity Center quota for %s has been reached. "+
+			"Delete unused resources or request a quota increase, "+
+			"see https://docs.aws.amazon.com/singlesignon/latest/userguide/limits.html.", err, quota)
+	}
+
+	return err.Error()
+}
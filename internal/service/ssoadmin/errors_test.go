@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+)
+
+func TestCreateErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err          error
+		expectQuota  bool
+		expectPrefix string
+	}{
+		"other error": {
+			err:          errors.New("boom"),
+			expectPrefix: "boom",
+		},
+		"quota exceeded": {
+			err:          &awstypes.ServiceQuotaExceededException{Message: aws.String("too many")},
+			expectQuota:  true,
+			expectPrefix: "ServiceQuotaExceededException: too many",
+		},
+		"wrapped quota exceeded": {
+			err:          fmt.Errorf("operation error: %w", &awstypes.ServiceQuotaExceededException{Message: aws.String("too many")}),
+			expectQuota:  true,
+			expectPrefix: "operation error: ServiceQuotaExceededException: too many",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := createErrorDetail(testCase.err, "trusted token issuers")
+
+			if !strings.HasPrefix(got, testCase.expectPrefix) {
+				t.Errorf("createErrorDetail() = %q, want prefix %q", got, testCase.expectPrefix)
+			}
+
+			if hasQuota := strings.Contains(got, "quota for trusted token issuers has been reached"); hasQuota != testCase.expectQuota {
+				t.Errorf("createErrorDetail() = %q, quota guidance present = %t, want %t", got, hasQuota, testCase.expectQuota)
+			}
+		})
+	}
+}
@@ -16,4 +16,6 @@ var (
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
 	FindApplicationAccessScopeByID             = findApplicationAccessScopeByID
 	FindTrustedTokenIssuerByARN                = findTrustedTokenIssuerByARN
+
+	FlattenAuthenticationMethodItems = flattenAuthenticationMethodItems
 )
@@ -18,10 +18,18 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceApplication,
 			Name:    "Application",
 		},
+		{
+			Factory: newDataSourceApplicationAssignmentConfiguration,
+			Name:    "Application Assignment Configuration",
+		},
 		{
 			Factory: newDataSourceApplicationAssignments,
 			Name:    "Application Assignments",
 		},
+		{
+			Factory: newDataSourceApplicationAuthenticationMethods,
+			Name:    "Application Authentication Methods",
+		},
 		{
 			Factory: newDataSourceApplicationProviders,
 			Name:    "Application Providers",
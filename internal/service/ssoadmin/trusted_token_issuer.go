@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -31,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -112,7 +114,10 @@ func (r *resourceTrustedTokenIssuer) Schema(ctx context.Context, req resource.Sc
 									"issuer_url": schema.StringAttribute{
 										Required: true,
 										PlanModifiers: []planmodifier.String{ // Not part of OidcJwtUpdateConfiguration struct, have to recreate at change
-											stringplanmodifier.RequiresReplace(),
+											issuerURLUseStateIfEqual(),
+											stringplanmodifier.RequiresReplaceIf(
+												issuerURLReplaceIf, "Replace issuer_url diff, ignoring a trailing slash", "Replace issuer_url diff, ignoring a trailing slash",
+											),
 										},
 									},
 									"jwks_retrieval_option": schema.StringAttribute{
@@ -170,7 +175,7 @@ func (r *resourceTrustedTokenIssuer) Create(ctx context.Context, req resource.Cr
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameTrustedTokenIssuer, plan.Name.String(), err),
-			err.Error(),
+			createErrorDetail(err, "trusted token issuers"),
 		)
 		return
 	}
@@ -211,6 +216,13 @@ func (r *resourceTrustedTokenIssuer) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	// Keep the configured form of issuer_url when it only differs by a trailing slash.
+	if v, ok := out.TrustedTokenIssuerConfiguration.(*awstypes.TrustedTokenIssuerConfigurationMemberOidcJwtConfiguration); ok {
+		if old := issuerURLFromConfiguration(ctx, state.TrustedTokenIssuerConfiguration); issuerURLsEqual(old, aws.ToString(v.Value.IssuerUrl)) {
+			v.Value.IssuerUrl = aws.String(old)
+		}
+	}
+
 	instanceARN, _ := TrustedTokenIssuerParseInstanceARN(r.Meta(), aws.ToString(out.TrustedTokenIssuerArn))
 
 	state.ARN = flex.StringToFramework(ctx, out.TrustedTokenIssuerArn)
@@ -331,13 +343,116 @@ func (r *resourceTrustedTokenIssuer) Delete(ctx context.Context, req resource.De
 }
 
 func (r *resourceTrustedTokenIssuer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	instanceARN, name, ok := trustedTokenIssuerParseImportID(req.ID)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+		return
+	}
+
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	input := &ssoadmin.ListTrustedTokenIssuersInput{
+		InstanceArn: aws.String(instanceARN),
+	}
+	issuers, err := findTrustedTokenIssuers(ctx, conn, input, func(v awstypes.TrustedTokenIssuerMetadata) bool {
+		return aws.ToString(v.Name) == name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionImporting, ResNameTrustedTokenIssuer, req.ID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	switch len(issuers) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Resource Import Invalid ID",
+			fmt.Sprintf("no trusted token issuer named %q found in instance %s", name, instanceARN),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Resource Import Invalid ID",
+			fmt.Sprintf("%d trusted token issuers named %q found in instance %s; import by ARN instead", len(issuers), name, instanceARN),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), aws.ToString(issuers[0].TrustedTokenIssuerArn))...)
 }
 
 func (r *resourceTrustedTokenIssuer) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
 
+// issuerURLUseStateIfEqualModifier keeps the prior issuer_url in the plan when the
+// configured value only differs from it by a trailing slash, so no update is planned.
+type issuerURLUseStateIfEqualModifier struct{}
+
+func issuerURLUseStateIfEqual() planmodifier.String {
+	return issuerURLUseStateIfEqualModifier{}
+}
+
+func (m issuerURLUseStateIfEqualModifier) Description(_ context.Context) string {
+	return "Keeps the prior issuer_url when the configured value only differs by a trailing slash."
+}
+
+func (m issuerURLUseStateIfEqualModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m issuerURLUseStateIfEqualModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if issuerURLsEqual(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func issuerURLReplaceIf(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.RequiresReplace = !issuerURLsEqual(req.StateValue.ValueString(), req.PlanValue.ValueString())
+}
+
+// issuerURLsEqual reports whether two OIDC issuer URLs are equal, ignoring a trailing slash.
+func issuerURLsEqual(a, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+func issuerURLFromConfiguration(ctx context.Context, tfList types.List) string {
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return ""
+	}
+
+	var configurations []TrustedTokenIssuerConfigurationData
+	if diags := tfList.ElementsAs(ctx, &configurations, false); diags.HasError() || len(configurations) == 0 {
+		return ""
+	}
+
+	var oidcJWTConfigurations []OIDCJWTConfigurationData
+	if diags := configurations[0].OIDCJWTConfiguration.ElementsAs(ctx, &oidcJWTConfigurations, false); diags.HasError() || len(oidcJWTConfigurations) == 0 {
+		return ""
+	}
+
+	return oidcJWTConfigurations[0].IssuerUrl.ValueString()
+}
+
 func findTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.Client, arn string) (*ssoadmin.DescribeTrustedTokenIssuerOutput, error) {
 	in := &ssoadmin.DescribeTrustedTokenIssuerInput{
 		TrustedTokenIssuerArn: aws.String(arn),
@@ -362,6 +477,33 @@ func findTrustedTokenIssuerByARN(ctx context.Context, conn *ssoadmin.Client, arn
 	return out, nil
 }
 
+func findTrustedTokenIssuers(ctx context.Context, conn *ssoadmin.Client, input *ssoadmin.ListTrustedTokenIssuersInput, filter tfslices.Predicate[awstypes.TrustedTokenIssuerMetadata]) ([]awstypes.TrustedTokenIssuerMetadata, error) {
+	var output []awstypes.TrustedTokenIssuerMetadata
+
+	paginator := ssoadmin.NewListTrustedTokenIssuersPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TrustedTokenIssuers {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
 func expandTrustedTokenIssuerConfiguration(ctx context.Context, tfList []TrustedTokenIssuerConfigurationData) (awstypes.TrustedTokenIssuerConfiguration, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -496,6 +638,25 @@ func TrustedTokenIssuerParseInstanceARN(conn *conns.AWSClient, id string) (strin
 	return "", diags
 }
 
+// trustedTokenIssuerParseImportID parses an import ID of the form
+// <instance_arn>/<name>, e.g. arn:aws:sso:::instance/ssoins-1234567890abcdef/example.
+// Any other ID, such as a trusted token issuer ARN, is not matched.
+func trustedTokenIssuerParseImportID(id string) (string, string, bool) {
+	parsedARN, err := arn.Parse(id)
+	if err != nil {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(parsedARN.Resource, "/", 3)
+	if len(parts) != 3 || parts[0] != "instance" || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+
+	name := parts[2]
+
+	return strings.TrimSuffix(id, "/"+name), name, true
+}
+
 type resourceTrustedTokenIssuerData struct {
 	ARN                             types.String `tfsdk:"arn"`
 	ClientToken                     types.String `tfsdk:"client_token"`
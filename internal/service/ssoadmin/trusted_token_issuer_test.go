@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -51,6 +52,12 @@ func TestAccSSOAdminTrustedTokenIssuer_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTrustedTokenIssuerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -95,6 +102,102 @@ func TestAccSSOAdminTrustedTokenIssuer_update(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminTrustedTokenIssuer_updateAttributePaths(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application ssoadmin.DescribeTrustedTokenIssuerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_trusted_token_issuer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustedTokenIssuerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedTokenIssuerConfigBase_basicUpdated(rName, names.AttrEmail, "emails.value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.claim_attribute_path", names.AttrEmail),
+				),
+			},
+			{
+				Config: testAccTrustedTokenIssuerConfigBase_basicUpdated(rName, "preferred_username", "emails.value"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.claim_attribute_path", "preferred_username"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.identity_store_attribute_path", "emails.value"),
+				),
+			},
+			{
+				Config: testAccTrustedTokenIssuerConfigBase_basicUpdated(rName, "preferred_username", "userName"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.claim_attribute_path", "preferred_username"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.identity_store_attribute_path", "userName"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminTrustedTokenIssuer_issuerURLTrailingSlash(t *testing.T) {
+	ctx := acctest.Context(t)
+	var application ssoadmin.DescribeTrustedTokenIssuerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_trusted_token_issuer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustedTokenIssuerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustedTokenIssuerConfigBase_issuerURL(rName, "https://example.com/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.issuer_url", "https://example.com/"),
+				),
+			},
+			{
+				Config: testAccTrustedTokenIssuerConfigBase_issuerURL(rName, "https://example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.issuer_url", "https://example.com/"),
+				),
+			},
+			{
+				Config: testAccTrustedTokenIssuerConfigBase_issuerURL(rName, "https://example.com/"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustedTokenIssuerExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "trusted_token_issuer_configuration.0.oidc_jwt_configuration.0.issuer_url", "https://example.com/"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminTrustedTokenIssuer_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var application ssoadmin.DescribeTrustedTokenIssuerOutput
@@ -188,6 +291,17 @@ func testAccCheckTrustedTokenIssuerExists(ctx context.Context, name string, trus
 	}
 }
 
+func testAccTrustedTokenIssuerImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_arn"], rs.Primary.Attributes[names.AttrName]), nil
+	}
+}
+
 func testAccCheckTrustedTokenIssuerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
@@ -254,6 +368,27 @@ resource "aws_ssoadmin_trusted_token_issuer" "test" {
 `, rNameUpdated, claimAttributePath, identityStoreAttributePath)
 }
 
+func testAccTrustedTokenIssuerConfigBase_issuerURL(rName, issuerURL string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_trusted_token_issuer" "test" {
+  name                      = %[1]q
+  instance_arn              = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  trusted_token_issuer_type = "OIDC_JWT"
+
+  trusted_token_issuer_configuration {
+    oidc_jwt_configuration {
+      claim_attribute_path          = "email"
+      identity_store_attribute_path = "emails.value"
+      issuer_url                    = %[2]q
+      jwks_retrieval_option         = "OPEN_ID_DISCOVERY"
+    }
+  }
+}
+`, rName, issuerURL)
+}
+
 func testAccTrustedTokenIssuerConfigBase_tags(rName, tagKey, tagValue string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
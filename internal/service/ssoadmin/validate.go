@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// applicationARNValidator validates that a string Attribute's value is an
+// IAM Identity Center application ARN.
+type applicationARNValidator struct{}
+
+func (v applicationARNValidator) Description(_ context.Context) string {
+	return "value must be an IAM Identity Center application ARN"
+}
+
+func (v applicationARNValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v applicationARNValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+		return
+	}
+
+	if strings.HasPrefix(parsed.Resource, "instance/") {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got an instance ARN: %s. Use the arn of the aws_ssoadmin_application resource rather than the instance ARN.", request.Path, v.Description(ctx), value),
+		)
+		return
+	}
+
+	if !strings.HasPrefix(parsed.Resource, "application/") {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// applicationARN returns a string validator which ensures that any configured
+// attribute value is an application ARN, not an instance ARN.
+func applicationARN() validator.String {
+	return applicationARNValidator{}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApplicationARNValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val                 types.String
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"application ARN": {
+			val: types.StringValue("arn:aws:sso::123456789012:application/ssoins-1111111111111111/apl-1111111111111111"), //lintignore:AWSAT005
+		},
+		"instance ARN": {
+			val: types.StringValue("arn:aws:sso:::instance/ssoins-1111111111111111"), //lintignore:AWSAT005
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be an IAM Identity Center application ARN, got an instance ARN: arn:aws:sso:::instance/ssoins-1111111111111111. Use the arn of the aws_ssoadmin_application resource rather than the instance ARN.`, //lintignore:AWSAT005
+				),
+			},
+		},
+		"other resource ARN": {
+			val: types.StringValue("arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111"), //lintignore:AWSAT005
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be an IAM Identity Center application ARN, got: arn:aws:sso:::permissionSet/ssoins-1111111111111111/ps-1111111111111111`, //lintignore:AWSAT005
+				),
+			},
+		},
+		"not an ARN": {
+			val: types.StringValue("test-value"),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					`Attribute test value must be an IAM Identity Center application ARN, got: test-value`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			applicationARN().ValidateString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
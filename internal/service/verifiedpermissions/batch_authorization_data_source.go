@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Batch Authorization")
+func newDataSourceBatchAuthorization(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceBatchAuthorization{}, nil
+}
+
+const (
+	DSNameBatchAuthorization = "Batch Authorization Data Source"
+)
+
+type dataSourceBatchAuthorization struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceBatchAuthorization) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_verifiedpermissions_batch_authorization"
+}
+
+func (d *dataSourceBatchAuthorization) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"entities_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+			},
+			"result": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[batchAuthorizationResultData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[batchAuthorizationResultData](ctx),
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"request": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[batchAuthorizationRequestData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 30),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action_id": schema.StringAttribute{
+							Required: true,
+						},
+						"action_type": schema.StringAttribute{
+							Required: true,
+						},
+						"context_json": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+							Validators: []validator.String{
+								cedarContextValidator{},
+							},
+						},
+						"principal_entity_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal_entity_type": schema.StringAttribute{
+							Required: true,
+						},
+						"resource_entity_id": schema.StringAttribute{
+							Required: true,
+						},
+						"resource_entity_type": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceBatchAuthorization) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().VerifiedPermissionsClient(ctx)
+
+	var data dataSourceBatchAuthorizationData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requests, diags := data.Requests.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.BatchIsAuthorizedInput{
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	}
+
+	if !data.EntitiesJSON.IsNull() {
+		entities, err := expandEntitiesDefinition(data.EntitiesJSON.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entities_json"),
+				"Invalid Cedar Entities",
+				err.Error(),
+			)
+			return
+		}
+
+		input.Entities = entities
+	}
+
+	for i, v := range requests {
+		request, err := expandBatchAuthorizationRequest(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request").AtListIndex(i).AtName("context_json"),
+				"Invalid Cedar Context",
+				err.Error(),
+			)
+			continue
+		}
+
+		input.Requests = append(input.Requests, request)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.BatchIsAuthorized(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, DSNameBatchAuthorization, data.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, out.PolicyStoreId)
+	data.Results = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, flattenBatchAuthorizationResults(ctx, input.Requests, out.Results))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func expandBatchAuthorizationRequest(tfObj *batchAuthorizationRequestData) (awstypes.BatchIsAuthorizedInputItem, error) {
+	apiObject := awstypes.BatchIsAuthorizedInputItem{
+		Action: &awstypes.ActionIdentifier{
+			ActionId:   aws.String(tfObj.ActionID.ValueString()),
+			ActionType: aws.String(tfObj.ActionType.ValueString()),
+		},
+		Principal: &awstypes.EntityIdentifier{
+			EntityId:   aws.String(tfObj.PrincipalEntityID.ValueString()),
+			EntityType: aws.String(tfObj.PrincipalEntityType.ValueString()),
+		},
+		Resource: &awstypes.EntityIdentifier{
+			EntityId:   aws.String(tfObj.ResourceEntityID.ValueString()),
+			EntityType: aws.String(tfObj.ResourceEntityType.ValueString()),
+		},
+	}
+
+	if !tfObj.ContextJSON.IsNull() {
+		contextDefinition, err := expandContextDefinition(tfObj.ContextJSON.ValueString())
+		if err != nil {
+			return apiObject, err
+		}
+
+		apiObject.Context = contextDefinition
+	}
+
+	return apiObject, nil
+}
+
+// expandContextDefinition converts a Cedar context, expressed as a JSON object, to a context map.
+func expandContextDefinition(s string) (awstypes.ContextDefinition, error) {
+	v, err := decodeCedarJSON(s)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("context must be a JSON object")
+	}
+
+	contextMap, err := expandAttributeValueMap(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return &awstypes.ContextDefinitionMemberContextMap{
+		Value: contextMap,
+	}, nil
+}
+
+// cedarContextValidator checks that a context is a JSON object in Cedar JSON format
+// whose values can all be expressed as attribute values.
+type cedarContextValidator struct{}
+
+func (v cedarContextValidator) Description(_ context.Context) string {
+	return "value must be a JSON object in Cedar JSON format"
+}
+
+func (v cedarContextValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarContextValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(request.ConfigValue.ValueString())) {
+		// Malformed JSON is reported by the attribute's custom type.
+		return
+	}
+
+	if _, err := expandContextDefinition(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Cedar Context",
+			err.Error(),
+		)
+	}
+}
+
+// expandEntitiesDefinition converts a list of Cedar entities, expressed as a JSON array
+// of {"uid": ..., "attrs": ..., "parents": [...]} objects, to an entity list.
+func expandEntitiesDefinition(s string) (awstypes.EntitiesDefinition, error) {
+	v, err := decodeCedarJSON(s)
+	if err != nil {
+		return nil, err
+	}
+
+	tfList, ok := v.([]any)
+	if !ok {
+		return nil, errors.New("entities must be a JSON array")
+	}
+
+	apiObjects := make([]awstypes.EntityItem, 0, len(tfList))
+	for i, tfItem := range tfList {
+		apiObject, err := expandEntityItem(tfItem)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return &awstypes.EntitiesDefinitionMemberEntityList{
+		Value: apiObjects,
+	}, nil
+}
+
+func expandEntityItem(v any) (awstypes.EntityItem, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return awstypes.EntityItem{}, errors.New("entity must be a JSON object")
+	}
+
+	uid, ok := m["uid"]
+	if !ok {
+		return awstypes.EntityItem{}, errors.New(`entity is missing required key "uid"`)
+	}
+	identifier, err := expandEntityReference(uid)
+	if err != nil {
+		return awstypes.EntityItem{}, fmt.Errorf("uid: %w", err)
+	}
+
+	apiObject := awstypes.EntityItem{
+		Identifier: &identifier,
+	}
+
+	if v, ok := m["attrs"]; ok {
+		attrs, ok := v.(map[string]any)
+		if !ok {
+			return awstypes.EntityItem{}, errors.New("attrs must be a JSON object")
+		}
+
+		attributes, err := expandAttributeValueMap(attrs)
+		if err != nil {
+			return awstypes.EntityItem{}, fmt.Errorf("attrs: %w", err)
+		}
+
+		apiObject.Attributes = attributes
+	}
+
+	if v, ok := m["parents"]; ok {
+		parents, ok := v.([]any)
+		if !ok {
+			return awstypes.EntityItem{}, errors.New("parents must be a JSON array")
+		}
+
+		for i, p := range parents {
+			parent, err := expandEntityReference(p)
+			if err != nil {
+				return awstypes.EntityItem{}, fmt.Errorf("parents[%d]: %w", i, err)
+			}
+
+			apiObject.Parents = append(apiObject.Parents, parent)
+		}
+	}
+
+	return apiObject, nil
+}
+
+// expandEntityReference accepts an entity reference either as {"type": ..., "id": ...}
+// or in the escaped {"__entity": {"type": ..., "id": ...}} form.
+func expandEntityReference(v any) (awstypes.EntityIdentifier, error) {
+	if m, ok := v.(map[string]any); ok {
+		if entity, ok := m["__entity"]; ok {
+			return expandEntityIdentifier(entity)
+		}
+	}
+
+	return expandEntityIdentifier(v)
+}
+
+func decodeCedarJSON(s string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func expandAttributeValueMap(m map[string]any) (map[string]awstypes.AttributeValue, error) {
+	apiObject := make(map[string]awstypes.AttributeValue, len(m))
+
+	for k, v := range m {
+		value, err := expandAttributeValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		apiObject[k] = value
+	}
+
+	return apiObject, nil
+}
+
+// expandAttributeValue converts a value in Cedar JSON format to an attribute value.
+// Entity references use the {"__entity": {"type": ..., "id": ...}} escape.
+func expandAttributeValue(v any) (awstypes.AttributeValue, error) {
+	switch v := v.(type) {
+	case bool:
+		return &awstypes.AttributeValueMemberBoolean{Value: v}, nil
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("number %s is not a 64-bit integer", v)
+		}
+
+		return &awstypes.AttributeValueMemberLong{Value: i}, nil
+	case string:
+		return &awstypes.AttributeValueMemberString{Value: v}, nil
+	case []any:
+		set := make([]awstypes.AttributeValue, 0, len(v))
+		for i, e := range v {
+			value, err := expandAttributeValue(e)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+
+			set = append(set, value)
+		}
+
+		return &awstypes.AttributeValueMemberSet{Value: set}, nil
+	case map[string]any:
+		if entity, ok := v["__entity"]; ok {
+			entityIdentifier, err := expandEntityIdentifier(entity)
+			if err != nil {
+				return nil, fmt.Errorf("__entity: %w", err)
+			}
+
+			return &awstypes.AttributeValueMemberEntityIdentifier{Value: entityIdentifier}, nil
+		}
+		// The SDK has no attribute value members for extension types such as ipaddr and decimal.
+		if extn, ok := v["__extn"]; ok {
+			var fn string
+			if m, ok := extn.(map[string]any); ok {
+				fn, _ = m["fn"].(string)
+			}
+
+			return nil, fmt.Errorf("extension function %q is not supported", fn)
+		}
+
+		record, err := expandAttributeValueMap(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &awstypes.AttributeValueMemberRecord{Value: record}, nil
+	case nil:
+		return nil, errors.New("null values are not supported")
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func expandEntityIdentifier(v any) (awstypes.EntityIdentifier, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return awstypes.EntityIdentifier{}, errors.New("entity reference must be a JSON object")
+	}
+
+	entityType, _ := m[names.AttrType].(string)
+	entityID, _ := m[names.AttrID].(string)
+	if entityType == "" || entityID == "" {
+		return awstypes.EntityIdentifier{}, errors.New(`entity reference requires string "type" and "id" keys`)
+	}
+
+	return awstypes.EntityIdentifier{
+		EntityId:   aws.String(entityID),
+		EntityType: aws.String(entityType),
+	}, nil
+}
+
+// flattenBatchAuthorizationResults returns one result per request, in request order.
+// Each result returned by the API echoes its request, which is used to match it back,
+// so the mapping doesn't depend on the order of the API response. Requests for the same
+// principal, action and resource are matched in the order the API returns them.
+func flattenBatchAuthorizationResults(ctx context.Context, requests []awstypes.BatchIsAuthorizedInputItem, apiObjects []awstypes.BatchIsAuthorizedOutputItem) []*batchAuthorizationResultData {
+	pending := make(map[string][]awstypes.BatchIsAuthorizedOutputItem)
+	for _, apiObject := range apiObjects {
+		if apiObject.Request == nil {
+			continue
+		}
+
+		key := batchAuthorizationRequestKey(*apiObject.Request)
+		pending[key] = append(pending[key], apiObject)
+	}
+
+	results := make([]*batchAuthorizationResultData, 0, len(requests))
+	for _, request := range requests {
+		key := batchAuthorizationRequestKey(request)
+
+		matches := pending[key]
+		if len(matches) == 0 {
+			results = append(results, &batchAuthorizationResultData{
+				Decision:            fwtypes.StringEnumNull[awstypes.Decision](),
+				DeterminingPolicies: fwtypes.NewListValueOfNull[types.String](ctx),
+				Errors:              fwflex.FlattenFrameworkStringValueListOfString(ctx, []string{"no result returned for request"}),
+			})
+			continue
+		}
+		apiObject := matches[0]
+		pending[key] = matches[1:]
+
+		var policyIDs []string
+		for _, v := range apiObject.DeterminingPolicies {
+			policyIDs = append(policyIDs, aws.ToString(v.PolicyId))
+		}
+
+		var errorDescriptions []string
+		for _, v := range apiObject.Errors {
+			errorDescriptions = append(errorDescriptions, aws.ToString(v.ErrorDescription))
+		}
+
+		results = append(results, &batchAuthorizationResultData{
+			Decision:            fwtypes.StringEnumValue(apiObject.Decision),
+			DeterminingPolicies: fwflex.FlattenFrameworkStringValueListOfString(ctx, policyIDs),
+			Errors:              fwflex.FlattenFrameworkStringValueListOfString(ctx, errorDescriptions),
+		})
+	}
+
+	return results
+}
+
+func batchAuthorizationRequestKey(apiObject awstypes.BatchIsAuthorizedInputItem) string {
+	var action, principal, resource [2]string
+
+	if v := apiObject.Action; v != nil {
+		action = [2]string{aws.ToString(v.ActionType), aws.ToString(v.ActionId)}
+	}
+	if v := apiObject.Principal; v != nil {
+		principal = [2]string{aws.ToString(v.EntityType), aws.ToString(v.EntityId)}
+	}
+	if v := apiObject.Resource; v != nil {
+		resource = [2]string{aws.ToString(v.EntityType), aws.ToString(v.EntityId)}
+	}
+
+	return fmt.Sprintf("%q %q %q", principal, action, resource)
+}
+
+type dataSourceBatchAuthorizationData struct {
+	EntitiesJSON  jsontypes.Normalized                                           `tfsdk:"entities_json"`
+	ID            types.String                                                   `tfsdk:"id"`
+	PolicyStoreID types.String                                                   `tfsdk:"policy_store_id"`
+	Requests      fwtypes.ListNestedObjectValueOf[batchAuthorizationRequestData] `tfsdk:"request"`
+	Results       fwtypes.ListNestedObjectValueOf[batchAuthorizationResultData]  `tfsdk:"result"`
+}
+
+type batchAuthorizationRequestData struct {
+	ActionID            types.String         `tfsdk:"action_id"`
+	ActionType          types.String         `tfsdk:"action_type"`
+	ContextJSON         jsontypes.Normalized `tfsdk:"context_json"`
+	PrincipalEntityID   types.String         `tfsdk:"principal_entity_id"`
+	PrincipalEntityType types.String         `tfsdk:"principal_entity_type"`
+	ResourceEntityID    types.String         `tfsdk:"resource_entity_id"`
+	ResourceEntityType  types.String         `tfsdk:"resource_entity_type"`
+}
+
+type batchAuthorizationResultData struct {
+	Decision            fwtypes.StringEnum[awstypes.Decision] `tfsdk:"decision"`
+	DeterminingPolicies fwtypes.ListValueOf[types.String]     `tfsdk:"determining_policies"`
+	Errors              fwtypes.ListValueOf[types.String]     `tfsdk:"errors"`
+}
@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandContextDefinition(t *testing.T) {
+	t.Parallel()
+
+	ignoreUnexported := cmpopts.IgnoreUnexported(
+		awstypes.AttributeValueMemberBoolean{},
+		awstypes.AttributeValueMemberEntityIdentifier{},
+		awstypes.AttributeValueMemberLong{},
+		awstypes.AttributeValueMemberRecord{},
+		awstypes.AttributeValueMemberSet{},
+		awstypes.AttributeValueMemberString{},
+		awstypes.ContextDefinitionMemberContextMap{},
+		awstypes.EntityIdentifier{},
+	)
+
+	testCases := map[string]struct {
+		input         string
+		expected      awstypes.ContextDefinition
+		expectedError *regexp.Regexp
+	}{
+		"empty": {
+			input: `{}`,
+			expected: &awstypes.ContextDefinitionMemberContextMap{
+				Value: map[string]awstypes.AttributeValue{},
+			},
+		},
+		"values": {
+			input: `{"mfa": true, "age": 9007199254740993, "name": "alice", "tags": ["a"], "owner": {"__entity": {"type": "User", "id": "bob"}}, "device": {"os": "linux"}}`,
+			expected: &awstypes.ContextDefinitionMemberContextMap{
+				Value: map[string]awstypes.AttributeValue{
+					"mfa":  &awstypes.AttributeValueMemberBoolean{Value: true},
+					"age":  &awstypes.AttributeValueMemberLong{Value: 9007199254740993},
+					"name": &awstypes.AttributeValueMemberString{Value: "alice"},
+					"tags": &awstypes.AttributeValueMemberSet{Value: []awstypes.AttributeValue{
+						&awstypes.AttributeValueMemberString{Value: "a"},
+					}},
+					"owner": &awstypes.AttributeValueMemberEntityIdentifier{Value: awstypes.EntityIdentifier{
+						EntityId:   aws.String("bob"),
+						EntityType: aws.String("User"),
+					}},
+					"device": &awstypes.AttributeValueMemberRecord{Value: map[string]awstypes.AttributeValue{
+						"os": &awstypes.AttributeValueMemberString{Value: "linux"},
+					}},
+				},
+			},
+		},
+		"not an object": {
+			input:         `[true]`,
+			expectedError: regexache.MustCompile(`context must be a JSON object`),
+		},
+		"not an integer": {
+			input:         `{"score": 1.5}`,
+			expectedError: regexache.MustCompile(`score: number 1.5 is not a 64-bit integer`),
+		},
+		"null": {
+			input:         `{"owner": null}`,
+			expectedError: regexache.MustCompile(`owner: null values are not supported`),
+		},
+		"ip extension": {
+			input:         `{"source": {"__extn": {"fn": "ip", "arg": "192.0.2.0/24"}}}`,
+			expectedError: regexache.MustCompile(`source: extension function "ip" is not supported`),
+		},
+		"decimal extension": {
+			input:         `{"amount": {"__extn": {"fn": "decimal", "arg": "1.5"}}}`,
+			expectedError: regexache.MustCompile(`amount: extension function "decimal" is not supported`),
+		},
+		"malformed extension": {
+			input:         `{"amount": {"__extn": "decimal"}}`,
+			expectedError: regexache.MustCompile(`amount: extension function "" is not supported`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfverifiedpermissions.ExpandContextDefinition(testCase.input)
+
+			if testCase.expectedError != nil {
+				if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, ignoreUnexported); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCedarContextValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val         types.String
+		expectError bool
+	}{
+		"null": {
+			val: types.StringNull(),
+		},
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"object": {
+			val: types.StringValue(`{"mfa": true, "owner": {"__entity": {"type": "User", "id": "bob"}}}`),
+		},
+		// Reported by jsontypes.NormalizedType.
+		"malformed": {
+			val: types.StringValue(`{"mfa": true`),
+		},
+		"not an object": {
+			val:         types.StringValue(`[true]`),
+			expectError: true,
+		},
+		"extension": {
+			val:         types.StringValue(`{"source": {"__extn": {"fn": "ip", "arg": "192.0.2.0/24"}}}`),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.val,
+			}
+			response := validator.StringResponse{}
+			tfverifiedpermissions.CedarContextValidator{}.ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAccVerifiedPermissionsBatchAuthorizationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_verifiedpermissions_batch_authorization.test"
+	policyResourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchAuthorizationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.decision", string(awstypes.DecisionAllow)),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.determining_policies.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.determining_policies.0", policyResourceName, "policy_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.decision", string(awstypes.DecisionDeny)),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.determining_policies.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsBatchAuthorizationDataSource_context(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_verifiedpermissions_batch_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchAuthorizationDataSourceConfig_context(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.decision", string(awstypes.DecisionAllow)),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.decision", string(awstypes.DecisionDeny)),
+					resource.TestCheckResourceAttr(dataSourceName, "result.2.decision", string(awstypes.DecisionDeny)),
+				),
+			},
+			{
+				Config:      testAccBatchAuthorizationDataSourceConfig_invalidContext(rName),
+				ExpectError: regexache.MustCompile(`context must be a JSON object`),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsBatchAuthorizationDataSource_entities(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_verifiedpermissions_batch_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchAuthorizationDataSourceConfig_entities(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.decision", string(awstypes.DecisionAllow)),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.decision", string(awstypes.DecisionDeny)),
+				),
+			},
+		},
+	})
+}
+
+func testAccBatchAuthorizationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      statement = "permit (principal == User::\"alice\", action == Action::\"view\", resource == Photo::\"photo.jpg\");"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccBatchAuthorizationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBatchAuthorizationDataSourceConfig_base(rName), `
+data "aws_verifiedpermissions_batch_authorization" "test" {
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "alice"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+  }
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "bob"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+  }
+}
+`)
+}
+
+func testAccBatchAuthorizationDataSourceConfig_context(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      statement = "permit (principal, action == Action::\"view\", resource) when { context has mfa && context.mfa && context.device == Device::\"laptop\" };"
+    }
+  }
+}
+
+data "aws_verifiedpermissions_batch_authorization" "test" {
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "alice"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+    context_json = jsonencode({
+      mfa    = true
+      device = { __entity = { type = "Device", id = "laptop" } }
+    })
+  }
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "alice"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+    context_json          = jsonencode({})
+  }
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "alice"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+  }
+}
+`, rName)
+}
+
+func testAccBatchAuthorizationDataSourceConfig_invalidContext(rName string) string {
+	return acctest.ConfigCompose(testAccBatchAuthorizationDataSourceConfig_base(rName), `
+data "aws_verifiedpermissions_batch_authorization" "test" {
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "alice"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+    context_json          = jsonencode([true])
+  }
+}
+`)
+}
+
+func testAccBatchAuthorizationDataSourceConfig_entities(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      statement = "permit (principal in Team::\"photographers\", action == Action::\"view\", resource) when { principal.department == \"engineering\" };"
+    }
+  }
+}
+
+data "aws_verifiedpermissions_batch_authorization" "test" {
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+
+  entities_json = jsonencode([
+    {
+      uid     = { type = "User", id = "alice" }
+      attrs   = { department = "engineering" }
+      parents = [{ type = "Team", id = "photographers" }]
+    },
+    {
+      uid     = { type = "User", id = "bob" }
+      attrs   = { department = "sales" }
+      parents = [{ type = "Team", id = "photographers" }]
+    },
+  ])
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "alice"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+  }
+
+  request {
+    principal_entity_type = "User"
+    principal_entity_id   = "bob"
+    action_type           = "Action"
+    action_id             = "view"
+    resource_entity_type  = "Photo"
+    resource_entity_id    = "photo.jpg"
+  }
+}
+`, rName)
+}
@@ -16,6 +16,11 @@ var (
 	FindSchemaByPolicyStoreID = findSchemaByPolicyStoreID
 )
 
+type CedarContextValidator = cedarContextValidator
+
 var (
-	PolicyTemplateParseID = policyTemplateParseID
+	CedarStatementsEqual    = cedarStatementsEqual
+	ExpandContextDefinition = expandContextDefinition
+	PolicyTemplateParseID   = policyTemplateParseID
+	PutSchemaWithRetry      = putSchemaWithRetry
 )
@@ -5,6 +5,7 @@ package verifiedpermissions
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
@@ -63,13 +64,36 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			names.AttrID: framework.IDAttribute(),
-			"policy_id":  framework.IDAttribute(),
+			names.AttrLastUpdatedDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": framework.IDAttribute(),
 			"policy_store_id": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			names.AttrPrincipal: schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[entityIdentifier](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[entityIdentifier](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[entityIdentifier](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[entityIdentifier](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"definition": schema.ListNestedBlock{
@@ -230,6 +254,32 @@ func statementReplaceIf(_ context.Context, req planmodifier.StringRequest, resp
 	resp.RequiresReplace = policyEffect || policyResource || policyPrincipal
 }
 
+// cedarStatementsEqual reports whether two Cedar statements consist of the same tokens,
+// i.e. they differ at most in whitespace and comments. Statements that cannot be tokenized are never equal.
+func cedarStatementsEqual(a, b string) bool {
+	tokensA, err := cedar.Tokenize([]byte(a))
+	if err != nil {
+		return false
+	}
+
+	tokensB, err := cedar.Tokenize([]byte(b))
+	if err != nil {
+		return false
+	}
+
+	if len(tokensA) != len(tokensB) {
+		return false
+	}
+
+	for i := range tokensA {
+		if tokensA[i].Text != tokensB[i].Text {
+			return false
+		}
+	}
+
+	return true
+}
+
 const (
 	ResourcePolicyIDPartsCount = 2
 )
@@ -315,7 +365,7 @@ func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicy, plan.PolicyStoreID.String(), err),
-			err.Error(),
+			policyCreateErrorDetail(ctx, conn, plan.PolicyStoreID.ValueString(), err),
 		)
 		return
 	}
@@ -336,7 +386,10 @@ func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest,
 
 	plan.ID = fwflex.StringValueToFramework(ctx, rID)
 	plan.CreatedDate = timetypes.NewRFC3339TimePointerValue(out.CreatedDate)
+	plan.LastUpdatedDate = timetypes.NewRFC3339TimePointerValue(out.LastUpdatedDate)
 	plan.PolicyID = fwflex.StringToFramework(ctx, out.PolicyId)
+	plan.Principal = flattenEntityIdentifier(ctx, out.Principal)
+	plan.Resource = flattenEntityIdentifier(ctx, out.Resource)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -376,50 +429,19 @@ func (r *resourcePolicy) Read(ctx context.Context, req resource.ReadRequest, res
 	state.PolicyID = fwflex.StringToFramework(ctx, out.PolicyId)
 	state.PolicyStoreID = fwflex.StringToFramework(ctx, out.PolicyStoreId)
 	state.CreatedDate = timetypes.NewRFC3339TimePointerValue(out.CreatedDate)
-
-	if val, ok := out.Definition.(*awstypes.PolicyDefinitionDetailMemberStatic); ok && val != nil {
-		static := fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &staticPolicyDefinition{
-			Statement:   fwflex.StringToFramework(ctx, val.Value.Statement),
-			Description: fwflex.StringToFramework(ctx, val.Value.Description),
-		})
-
-		state.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &policyDefinition{
-			Static:         static,
-			TemplateLinked: fwtypes.NewListNestedObjectValueOfNull[templateLinkedPolicyDefinition](ctx),
-		})
-	}
-
-	if val, ok := out.Definition.(*awstypes.PolicyDefinitionDetailMemberTemplateLinked); ok && val != nil {
-		tpl := templateLinkedPolicyDefinition{
-			PolicyTemplateID: fwflex.StringToFramework(ctx, val.Value.PolicyTemplateId),
+	state.LastUpdatedDate = timetypes.NewRFC3339TimePointerValue(out.LastUpdatedDate)
+	state.Principal = flattenEntityIdentifier(ctx, out.Principal)
+	state.Resource = flattenEntityIdentifier(ctx, out.Resource)
+
+	var priorStatement types.String
+	if def, diags := state.Definition.ToPtr(ctx); !diags.HasError() && def != nil {
+		if old, diags := def.Static.ToPtr(ctx); !diags.HasError() && old != nil {
+			priorStatement = old.Statement
 		}
-
-		if val.Value.Principal != nil {
-			tpl.Principal = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedPrincipal{
-				EntityID:   fwflex.StringToFramework(ctx, val.Value.Principal.EntityId),
-				EntityType: fwflex.StringToFramework(ctx, val.Value.Principal.EntityType),
-			})
-		} else {
-			tpl.Principal = fwtypes.NewListNestedObjectValueOfNull[templateLinkedPrincipal](ctx)
-		}
-
-		if val.Value.Resource != nil {
-			tpl.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedResource{
-				EntityID:   fwflex.StringToFramework(ctx, val.Value.Resource.EntityId),
-				EntityType: fwflex.StringToFramework(ctx, val.Value.Resource.EntityType),
-			})
-		} else {
-			tpl.Resource = fwtypes.NewListNestedObjectValueOfNull[templateLinkedResource](ctx)
-		}
-
-		templateLinked := fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tpl)
-
-		state.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &policyDefinition{
-			Static:         fwtypes.NewListNestedObjectValueOfNull[staticPolicyDefinition](ctx),
-			TemplateLinked: templateLinked,
-		})
 	}
 
+	state.Definition = flattenPolicyDefinitionDetail(ctx, out.Definition, priorStatement)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -465,7 +487,7 @@ func (r *resourcePolicy) Update(ctx context.Context, req resource.UpdateRequest,
 			}
 		}
 
-		_, err := conn.UpdatePolicy(ctx, in)
+		out, err := conn.UpdatePolicy(ctx, in)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicy, plan.ID.String(), err),
@@ -473,6 +495,14 @@ func (r *resourcePolicy) Update(ctx context.Context, req resource.UpdateRequest,
 			)
 			return
 		}
+
+		plan.LastUpdatedDate = timetypes.NewRFC3339TimePointerValue(out.LastUpdatedDate)
+		plan.Principal = flattenEntityIdentifier(ctx, out.Principal)
+		plan.Resource = flattenEntityIdentifier(ctx, out.Resource)
+	} else {
+		plan.LastUpdatedDate = state.LastUpdatedDate
+		plan.Principal = state.Principal
+		plan.Resource = state.Resource
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -535,6 +565,13 @@ func (r *resourcePolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 			if !defState.TemplateLinked.IsNull() && defPlan.TemplateLinked.IsNull() {
 				resp.RequiresReplace = []path.Path{path.Root("definition").AtListIndex(0).AtName("template_linked")}
 			}
+
+			// An in-place update of the definition bumps the update date and may change the policy's scope.
+			plan.LastUpdatedDate = timetypes.NewRFC3339Unknown()
+			plan.Principal = fwtypes.NewListNestedObjectValueOfUnknown[entityIdentifier](ctx)
+			plan.Resource = fwtypes.NewListNestedObjectValueOfUnknown[entityIdentifier](ctx)
+
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		}
 	}
 }
@@ -563,12 +600,106 @@ func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, id, p
 	return out, nil
 }
 
+// policyCreateErrorDetail returns the diagnostic detail for a failed CreatePolicy.
+// Policies in a policy store with STRICT validation are validated against the schema,
+// so when the store has no schema yet the error explains that the schema must be created first.
+func policyCreateErrorDetail(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID string, err error) string {
+	if !errs.IsA[*awstypes.ValidationException](err) {
+		return err.Error()
+	}
+
+	policyStore, findErr := findPolicyStoreByID(ctx, conn, policyStoreID)
+	if findErr != nil || policyStore.ValidationSettings == nil || policyStore.ValidationSettings.Mode != awstypes.ValidationModeStrict {
+		return err.Error()
+	}
+
+	if _, findErr := findSchemaByPolicyStoreID(ctx, conn, policyStoreID); !tfresource.NotFound(findErr) {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("%s\n\nPolicy store %s uses STRICT validation but has no schema. "+
+		"Policies are validated against the schema, so the aws_verifiedpermissions_schema resource must be created first. "+
+		"Reference it from the policy, e.g. with depends_on, so that Terraform creates the schema before the policy.", err, policyStoreID)
+}
+
+func flattenEntityIdentifier(ctx context.Context, apiObject *awstypes.EntityIdentifier) fwtypes.ListNestedObjectValueOf[entityIdentifier] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[entityIdentifier](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &entityIdentifier{
+		EntityID:   fwflex.StringToFramework(ctx, apiObject.EntityId),
+		EntityType: fwflex.StringToFramework(ctx, apiObject.EntityType),
+	})
+}
+
+// flattenPolicyDefinitionDetail flattens a policy definition returned by GetPolicy.
+// For static policies, priorStatement is kept if it differs from the stored statement only by whitespace.
+func flattenPolicyDefinitionDetail(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail, priorStatement types.String) fwtypes.ListNestedObjectValueOf[policyDefinition] {
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		statement := fwflex.StringToFramework(ctx, v.Value.Statement)
+
+		if !priorStatement.IsNull() && cedarStatementsEqual(priorStatement.ValueString(), aws.ToString(v.Value.Statement)) {
+			statement = priorStatement
+		}
+
+		static := fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &staticPolicyDefinition{
+			Statement:   statement,
+			Description: fwflex.StringToFramework(ctx, v.Value.Description),
+		})
+
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &policyDefinition{
+			Static:         static,
+			TemplateLinked: fwtypes.NewListNestedObjectValueOfNull[templateLinkedPolicyDefinition](ctx),
+		})
+
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		tpl := templateLinkedPolicyDefinition{
+			PolicyTemplateID: fwflex.StringToFramework(ctx, v.Value.PolicyTemplateId),
+		}
+
+		if v.Value.Principal != nil {
+			tpl.Principal = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedPrincipal{
+				EntityID:   fwflex.StringToFramework(ctx, v.Value.Principal.EntityId),
+				EntityType: fwflex.StringToFramework(ctx, v.Value.Principal.EntityType),
+			})
+		} else {
+			tpl.Principal = fwtypes.NewListNestedObjectValueOfNull[templateLinkedPrincipal](ctx)
+		}
+
+		if v.Value.Resource != nil {
+			tpl.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedResource{
+				EntityID:   fwflex.StringToFramework(ctx, v.Value.Resource.EntityId),
+				EntityType: fwflex.StringToFramework(ctx, v.Value.Resource.EntityType),
+			})
+		} else {
+			tpl.Resource = fwtypes.NewListNestedObjectValueOfNull[templateLinkedResource](ctx)
+		}
+
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &policyDefinition{
+			Static:         fwtypes.NewListNestedObjectValueOfNull[staticPolicyDefinition](ctx),
+			TemplateLinked: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tpl),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfNull[policyDefinition](ctx)
+}
+
 type resourcePolicyData struct {
-	CreatedDate   timetypes.RFC3339                                 `tfsdk:"created_date"`
-	Definition    fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
-	ID            types.String                                      `tfsdk:"id"`
-	PolicyID      types.String                                      `tfsdk:"policy_id"`
-	PolicyStoreID types.String                                      `tfsdk:"policy_store_id"`
+	CreatedDate     timetypes.RFC3339                                 `tfsdk:"created_date"`
+	Definition      fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
+	ID              types.String                                      `tfsdk:"id"`
+	LastUpdatedDate timetypes.RFC3339                                 `tfsdk:"last_updated_date"`
+	PolicyID        types.String                                      `tfsdk:"policy_id"`
+	PolicyStoreID   types.String                                      `tfsdk:"policy_store_id"`
+	Principal       fwtypes.ListNestedObjectValueOf[entityIdentifier] `tfsdk:"principal"`
+	Resource        fwtypes.ListNestedObjectValueOf[entityIdentifier] `tfsdk:"resource"`
+}
+
+type entityIdentifier struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
 }
 
 type policyDefinition struct {
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Policy")
+func newDataSourcePolicy(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourcePolicy{}, nil
+}
+
+const (
+	DSNamePolicy = "Policy Data Source"
+)
+
+type dataSourcePolicy struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourcePolicy) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (d *dataSourcePolicy) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"definition": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[policyDefinition](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[policyDefinition](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrLastUpdatedDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"policy_id": schema.StringAttribute{
+				Required: true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Computed:   true,
+			},
+			names.AttrPrincipal: schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[entityIdentifier](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[entityIdentifier](ctx),
+				Computed:    true,
+			},
+			"resource": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[entityIdentifier](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[entityIdentifier](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourcePolicy) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().VerifiedPermissionsClient(ctx)
+
+	var data dataSourcePolicyData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPolicyByID(ctx, conn, data.PolicyID.ValueString(), data.PolicyStoreID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, DSNamePolicy, data.PolicyID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.CreatedDate = timetypes.NewRFC3339TimePointerValue(out.CreatedDate)
+	data.Definition = flattenPolicyDefinitionDetail(ctx, out.Definition, types.StringNull())
+	data.ID = fwflex.StringToFramework(ctx, out.PolicyId)
+	data.LastUpdatedDate = timetypes.NewRFC3339TimePointerValue(out.LastUpdatedDate)
+	data.PolicyType = fwtypes.StringEnumValue(out.PolicyType)
+	data.Principal = flattenEntityIdentifier(ctx, out.Principal)
+	data.Resource = flattenEntityIdentifier(ctx, out.Resource)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourcePolicyData struct {
+	CreatedDate     timetypes.RFC3339                                 `tfsdk:"created_date"`
+	Definition      fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
+	ID              types.String                                      `tfsdk:"id"`
+	LastUpdatedDate timetypes.RFC3339                                 `tfsdk:"last_updated_date"`
+	PolicyID        types.String                                      `tfsdk:"policy_id"`
+	PolicyStoreID   types.String                                      `tfsdk:"policy_store_id"`
+	PolicyType      fwtypes.StringEnum[awstypes.PolicyType]           `tfsdk:"policy_type"`
+	Principal       fwtypes.ListNestedObjectValueOf[entityIdentifier] `tfsdk:"principal"`
+	Resource        fwtypes.ListNestedObjectValueOf[entityIdentifier] `tfsdk:"resource"`
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_verifiedpermissions_policy.test"
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	policyStatement := "permit (principal, action == Action::\"view\", resource in Album:: \"test_album\");"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDataSourceConfig_basic(rName, policyStatement),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreatedDate, resourceName, names.AttrCreatedDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "definition.0.static.0.description", resourceName, "definition.0.static.0.description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "definition.0.static.0.statement", resourceName, "definition.0.static.0.statement"),
+					resource.TestCheckResourceAttr(dataSourceName, "definition.0.template_linked.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrLastUpdatedDate, resourceName, names.AttrLastUpdatedDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_id", resourceName, "policy_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", string(awstypes.PolicyTypeStatic)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyDataSource_templateLinked(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_verifiedpermissions_policy.test"
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDataSourceConfig_templateLinked(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "definition.0.static.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "definition.0.template_linked.0.policy_template_id", resourceName, "definition.0.template_linked.0.policy_template_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "definition.0.template_linked.0.principal.0.entity_id", "TestUsers"),
+					resource.TestCheckResourceAttr(dataSourceName, "definition.0.template_linked.0.principal.0.entity_type", "User"),
+					resource.TestCheckResourceAttr(dataSourceName, "definition.0.template_linked.0.resource.0.entity_id", "test_album"),
+					resource.TestCheckResourceAttr(dataSourceName, "definition.0.template_linked.0.resource.0.entity_type", "Album"),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", string(awstypes.PolicyTypeTemplateLinked)),
+					resource.TestCheckResourceAttr(dataSourceName, "principal.0.entity_id", "TestUsers"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource.0.entity_id", "test_album"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyDataSourceConfig_basic(rName, policyStatement string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_basic(rName, policyStatement), `
+data "aws_verifiedpermissions_policy" "test" {
+  policy_id       = aws_verifiedpermissions_policy.test.policy_id
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+}
+`)
+}
+
+func testAccPolicyDataSourceConfig_templateLinked(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_templateLinked(rName), `
+data "aws_verifiedpermissions_policy" "test" {
+  policy_id       = aws_verifiedpermissions_policy.test.policy_id
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+}
+`)
+}
@@ -5,11 +5,13 @@ package verifiedpermissions
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -73,8 +76,13 @@ func (r *resourcePolicyStore) Schema(ctx context.Context, request resource.Schem
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						names.AttrMode: schema.StringAttribute{
-							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
-							Required:   true,
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive(enum.Values[awstypes.ValidationMode]()...),
+							},
+							PlanModifiers: []planmodifier.String{
+								validationModeUseStateIfEqualFold(),
+							},
 						},
 					},
 				},
@@ -102,6 +110,8 @@ func (r *resourcePolicyStore) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
+	normalizeValidationSettings(input.ValidationSettings)
+
 	clientToken := id.UniqueId()
 	input.ClientToken = aws.String(clientToken)
 
@@ -152,12 +162,29 @@ func (r *resourcePolicyStore) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
+	priorMode := validationModeFromSettings(ctx, state.ValidationSettings)
+
 	response.Diagnostics.Append(flex.Flatten(ctx, output, &state)...)
 
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	mode := string(awstypes.ValidationModeOff)
+	// The API omits the validation mode for stores that have never been switched to STRICT.
+	if output.ValidationSettings != nil && output.ValidationSettings.Mode != "" {
+		mode = string(output.ValidationSettings.Mode)
+	}
+
+	// Keep the configured casing, the API only ever returns upper case.
+	if strings.EqualFold(priorMode, mode) {
+		mode = priorMode
+	}
+
+	state.ValidationSettings = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &validationSettings{
+		Mode: types.StringValue(mode),
+	})
+
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
 
@@ -185,6 +212,8 @@ func (r *resourcePolicyStore) Update(ctx context.Context, request resource.Updat
 			return
 		}
 
+		normalizeValidationSettings(input.ValidationSettings)
+
 		output, err := conn.UpdatePolicyStore(ctx, input)
 
 		if err != nil {
@@ -247,7 +276,52 @@ type resourcePolicyStoreData struct {
 }
 
 type validationSettings struct {
-	Mode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"mode"`
+	Mode types.String `tfsdk:"mode"`
+}
+
+// normalizeValidationSettings upper-cases the validation mode, which may be
+// configured in any case.
+func normalizeValidationSettings(apiObject *awstypes.ValidationSettings) {
+	if apiObject == nil {
+		return
+	}
+
+	apiObject.Mode = awstypes.ValidationMode(strings.ToUpper(string(apiObject.Mode)))
+}
+
+func validationModeFromSettings(ctx context.Context, settings fwtypes.ListNestedObjectValueOf[validationSettings]) string {
+	v, diags := settings.ToPtr(ctx)
+	if diags.HasError() || v == nil {
+		return ""
+	}
+
+	return v.Mode.ValueString()
+}
+
+// validationModeUseStateIfEqualFoldModifier keeps the prior mode in the plan when the
+// configured value only differs from it in case, so no update is planned.
+type validationModeUseStateIfEqualFoldModifier struct{}
+
+func validationModeUseStateIfEqualFold() planmodifier.String {
+	return validationModeUseStateIfEqualFoldModifier{}
+}
+
+func (m validationModeUseStateIfEqualFoldModifier) Description(_ context.Context) string {
+	return "Keeps the prior mode when the configured value only differs in case."
+}
+
+func (m validationModeUseStateIfEqualFoldModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m validationModeUseStateIfEqualFoldModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
 }
 
 func findPolicyStoreByID(ctx context.Context, conn *verifiedpermissions.Client, id string) (*verifiedpermissions.GetPolicyStoreOutput, error) {
@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -23,6 +26,72 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestCedarStatementsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"identical": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    `permit (principal, action == Action::"view", resource);`,
+			want: true,
+		},
+		"whitespace only": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    "permit(\n  principal,\n  action == Action::\"view\",\n  resource\n);\n",
+			want: true,
+		},
+		"line comment only": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    "// Allow viewing.\npermit (principal, action == Action::\"view\", resource);",
+			want: true,
+		},
+		"block comment only": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    `permit (principal, /* any */ action == Action::"view", resource);`,
+			want: true,
+		},
+		"different action": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    `permit (principal, action == Action::"write", resource);`,
+			want: false,
+		},
+		"different effect": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    `forbid (principal, action == Action::"view", resource);`,
+			want: false,
+		},
+		"whitespace inside string literal": {
+			a:    `permit (principal, action == Action::"view", resource in Album::"test album");`,
+			b:    `permit (principal, action == Action::"view", resource in Album::"test  album");`,
+			want: false,
+		},
+		"additional condition": {
+			a:    `permit (principal, action == Action::"view", resource);`,
+			b:    `permit (principal, action == Action::"view", resource) when { true };`,
+			want: false,
+		},
+		"tokenize failure": {
+			a:    `permit (principal, action == Action::"view, resource);`,
+			b:    `permit (principal, action == Action::"view, resource);`,
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfverifiedpermissions.CedarStatementsEqual(testCase.a, testCase.b), testCase.want; got != want {
+				t.Errorf("CedarStatementsEqual(%q, %q) = %t, want %t", testCase.a, testCase.b, got, want)
+			}
+		})
+	}
+}
+
 func TestAccVerifiedPermissionsPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -50,7 +119,12 @@ func TestAccVerifiedPermissionsPolicy_basic(t *testing.T) {
 					testAccCheckPolicyExists(ctx, resourceName, &policy),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", rName),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", policyStatement),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedDate),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrLastUpdatedDate),
 					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "resource.0.entity_id", "test_album"),
+					resource.TestCheckResourceAttr(resourceName, "resource.0.entity_type", "Album"),
 				),
 			},
 			{
@@ -91,6 +165,12 @@ func TestAccVerifiedPermissionsPolicy_templateLinked(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_id", "test_album"),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.template_linked.0.resource.0.entity_type", "Album"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "principal.0.entity_id", "TestUsers"),
+					resource.TestCheckResourceAttr(resourceName, "principal.0.entity_type", "User"),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource.0.entity_id", "test_album"),
+					resource.TestCheckResourceAttr(resourceName, "resource.0.entity_type", "Album"),
 				),
 			},
 			{
@@ -140,6 +220,7 @@ func TestAccVerifiedPermissionsPolicy_update(t *testing.T) {
 					testAccCheckPolicyExists(ctx, resourceName, &policy),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", rName),
 					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", policyStatementActionUpdated),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrLastUpdatedDate),
 					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
 				),
 			},
@@ -156,6 +237,58 @@ func TestAccVerifiedPermissionsPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsPolicy_updateDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	policyStatement := "permit (principal, action == Action::\"view\", resource in Album:: \"test_album\");"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_description(rName, "description1", policyStatement),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", policyStatement),
+				),
+			},
+			{
+				Config: testAccPolicyConfig_description(rName, "description2", policyStatement),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New(names.AttrLastUpdatedDate)),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.static.0.statement", policyStatement),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -189,6 +322,33 @@ func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsPolicy_strictWithoutSchema(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	policyStatement := "permit (principal, action == Action::\"view\", resource in Album:: \"test_album\");"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_strictWithoutSchema(rName, policyStatement),
+				ExpectError: regexache.MustCompile(`uses STRICT validation but has no schema`),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
@@ -286,6 +446,45 @@ resource "aws_verifiedpermissions_policy" "test" {
 `, rName, policyStatement))
 }
 
+func testAccPolicyConfig_strictWithoutSchema(rName, policyStatement string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      description = %[1]q
+      statement   = %[2]q
+    }
+  }
+}
+`, rName, policyStatement)
+}
+
+func testAccPolicyConfig_description(rName, description, policyStatement string) string {
+	return acctest.ConfigCompose(
+		testAccPolicyConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      description = %[1]q
+      statement   = %[2]q
+    }
+  }
+}
+`, description, policyStatement))
+}
+
 func testAccPolicyConfig_templateLinked(rName string) string {
 	return acctest.ConfigCompose(
 		testAccPolicyConfig_base(rName),
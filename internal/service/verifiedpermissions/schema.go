@@ -5,11 +5,16 @@ package verifiedpermissions
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -33,6 +38,10 @@ import (
 func newResourceSchema(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceSchema{}
 
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
 	return r, nil
 }
 
@@ -43,6 +52,7 @@ const (
 type resourceSchema struct {
 	framework.ResourceWithConfigure
 	framework.WithImportByID
+	framework.WithTimeouts
 }
 
 func (r *resourceSchema) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -70,9 +80,17 @@ func (r *resourceSchema) Schema(ctx context.Context, request resource.SchemaRequ
 					names.AttrValue: schema.StringAttribute{
 						CustomType: jsontypes.NormalizedType{},
 						Required:   true,
+						Validators: []validator.String{
+							cedarJSONSchemaValidator{},
+						},
 					},
 				},
 			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 
@@ -98,7 +116,7 @@ func (r *resourceSchema) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
-	output, err := conn.PutSchema(ctx, input)
+	output, err := putSchema(ctx, conn, input, r.CreateTimeout(ctx, plan.Timeouts))
 
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -135,7 +153,7 @@ func (r *resourceSchema) Read(ctx context.Context, request resource.ReadRequest,
 
 	if err != nil {
 		response.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyStoreSchema, state.PolicyStoreID.ValueString(), err),
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyStoreSchema, state.ID.ValueString(), err),
 			err.Error(),
 		)
 		return
@@ -178,7 +196,7 @@ func (r *resourceSchema) Update(ctx context.Context, request resource.UpdateRequ
 			return
 		}
 
-		_, err := conn.PutSchema(ctx, input)
+		_, err := putSchema(ctx, conn, input, r.UpdateTimeout(ctx, plan.Timeouts))
 
 		if err != nil {
 			response.Diagnostics.AddError(
@@ -226,7 +244,7 @@ func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequ
 		},
 	}
 
-	_, err := conn.PutSchema(ctx, input)
+	_, err := putSchema(ctx, conn, input, r.DeleteTimeout(ctx, state.Timeouts))
 
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -238,10 +256,11 @@ func (r *resourceSchema) Delete(ctx context.Context, request resource.DeleteRequ
 }
 
 type resourceSchemaData struct {
-	ID            types.String `tfsdk:"id"`
-	Definition    types.Object `tfsdk:"definition"`
-	Namespaces    types.Set    `tfsdk:"namespaces"`
-	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+	ID            types.String   `tfsdk:"id"`
+	Definition    types.Object   `tfsdk:"definition"`
+	Namespaces    types.Set      `tfsdk:"namespaces"`
+	PolicyStoreID types.String   `tfsdk:"policy_store_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 type definition struct {
@@ -296,3 +315,95 @@ func flattenDefinition(ctx context.Context, input *verifiedpermissions.GetSchema
 
 	return types.ObjectValueMust(attributeTypes, attrs)
 }
+
+type putSchemaFunc func(context.Context, *verifiedpermissions.PutSchemaInput, ...func(*verifiedpermissions.Options)) (*verifiedpermissions.PutSchemaOutput, error)
+
+// putSchema calls PutSchema, retrying while a concurrent update to the policy store
+// causes a ConflictException.
+func putSchema(ctx context.Context, conn *verifiedpermissions.Client, input *verifiedpermissions.PutSchemaInput, timeout time.Duration) (*verifiedpermissions.PutSchemaOutput, error) {
+	return putSchemaWithRetry(ctx, conn.PutSchema, input, timeout)
+}
+
+// putSchemaWithRetry calls put, retrying while the policy store reports a conflicting update.
+func putSchemaWithRetry(ctx context.Context, put putSchemaFunc, input *verifiedpermissions.PutSchemaInput, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*verifiedpermissions.PutSchemaOutput, error) {
+	var output *verifiedpermissions.PutSchemaOutput
+
+	err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+		var err error
+
+		output, err = put(ctx, input)
+
+		if errs.IsA[*awstypes.ConflictException](err) {
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	}, optFns...)
+
+	if tfresource.TimedOut(err) {
+		output, err = put(ctx, input)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// cedarJSONSchemaValidator performs a light structural check of a Cedar JSON schema:
+// every top-level namespace must be an object defining "entityTypes" and "actions".
+// Cedar-specific semantics are left to the API.
+type cedarJSONSchemaValidator struct{}
+
+func (v cedarJSONSchemaValidator) Description(_ context.Context) string {
+	return `each namespace must define "entityTypes" and "actions"`
+}
+
+func (v cedarJSONSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarJSONSchemaValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var namespaces map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(request.ConfigValue.ValueString()), &namespaces); err != nil {
+		// Malformed JSON is reported by the attribute's custom type.
+		return
+	}
+
+	keys := make([]string, 0, len(namespaces))
+	for name := range namespaces {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+
+	for _, name := range keys {
+		var namespace map[string]json.RawMessage
+		if err := json.Unmarshal(namespaces[name], &namespace); err != nil {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Cedar Schema",
+				fmt.Sprintf("namespace %q must be a JSON object", name),
+			)
+			continue
+		}
+
+		for _, key := range []string{"entityTypes", "actions"} {
+			if _, ok := namespace[key]; !ok {
+				response.Diagnostics.AddAttributeError(
+					request.Path,
+					"Invalid Cedar Schema",
+					fmt.Sprintf("namespace %q is missing required key %q", name, key),
+				)
+			}
+		}
+	}
+}
@@ -7,9 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -89,6 +94,45 @@ func TestAccVerifiedPermissionsSchema_update(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "CHANGED"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSchemaImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition.value"}, // JSON is semantically correct but can be set in state in a different order
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsSchema_multipleNamespaces(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schema verifiedpermissions.GetSchemaOutput
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_definition(`{\"NAMESPACE1\":{\"actions\":{},\"entityTypes\":{}},\"NAMESPACE2\":{\"actions\":{},\"entityTypes\":{}}}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &schema),
+					resource.TestCheckResourceAttr(resourceName, "namespaces.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "NAMESPACE1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "NAMESPACE2"),
+				),
+			},
 		},
 	})
 }
@@ -124,6 +168,172 @@ func TestAccVerifiedPermissionsSchema_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsSchema_invalidDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSchemaConfig_definition(`{\"NAMESPACE\":{\"actions\":{}}}`),
+				ExpectError: regexache.MustCompile(`namespace "NAMESPACE" is missing required key "entityTypes"`),
+			},
+			{
+				Config:      testAccSchemaConfig_definition(`{\"NAMESPACE\":[]}`),
+				ExpectError: regexache.MustCompile(`namespace "NAMESPACE" must be a JSON object`),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsSchema_timeouts(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schema verifiedpermissions.GetSchemaOutput
+	resourceName := "aws_verifiedpermissions_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+			testAccPolicyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_timeouts("NAMESPACE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &schema),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "10m"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.update", "10m"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.delete", "10m"),
+				),
+			},
+			{
+				Config: testAccSchemaConfig_timeouts("CHANGED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &schema),
+					resource.TestCheckTypeSetElemAttr(resourceName, "namespaces.*", "CHANGED"),
+				),
+			},
+		},
+	})
+}
+
+func TestPutSchemaWithRetry(t *testing.T) {
+	t.Parallel()
+
+	conflict := &awstypes.ConflictException{Message: aws.String("conflict")}
+	validation := &awstypes.ValidationException{Message: aws.String("invalid")}
+
+	testCases := []struct {
+		name          string
+		errs          []error // returned by successive calls; success once exhausted
+		alwaysFail    error
+		timeout       time.Duration
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "success",
+			timeout:       time.Minute,
+			expectedCalls: 1,
+		},
+		{
+			name:          "conflicts then success",
+			errs:          []error{conflict, conflict},
+			timeout:       time.Minute,
+			expectedCalls: 3,
+		},
+		{
+			name:          "non-conflict error",
+			errs:          []error{validation},
+			timeout:       time.Minute,
+			expectedCalls: 1,
+			expectedErr:   validation,
+		},
+		{
+			name:        "conflict until timeout",
+			alwaysFail:  conflict,
+			timeout:     100 * time.Millisecond,
+			expectedErr: conflict,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			input := &verifiedpermissions.PutSchemaInput{PolicyStoreId: aws.String("ps-test")}
+
+			var calls atomic.Int32
+			put := func(_ context.Context, in *verifiedpermissions.PutSchemaInput, _ ...func(*verifiedpermissions.Options)) (*verifiedpermissions.PutSchemaOutput, error) {
+				n := int(calls.Add(1))
+				if testCase.alwaysFail != nil {
+					return nil, testCase.alwaysFail
+				}
+				if n <= len(testCase.errs) {
+					return nil, testCase.errs[n-1]
+				}
+				return &verifiedpermissions.PutSchemaOutput{PolicyStoreId: in.PolicyStoreId}, nil
+			}
+
+			start := time.Now()
+			output, err := tfverifiedpermissions.PutSchemaWithRetry(ctx, put, input, testCase.timeout, tfresource.WithPollInterval(time.Millisecond))
+			elapsed := time.Since(start)
+
+			if testCase.expectedErr != nil {
+				if !errors.Is(err, testCase.expectedErr) {
+					t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got, want := aws.ToString(output.PolicyStoreId), "ps-test"; got != want {
+					t.Errorf("PolicyStoreId = %q, want %q", got, want)
+				}
+			}
+
+			if got := int(calls.Load()); testCase.expectedCalls > 0 && got != testCase.expectedCalls {
+				t.Errorf("expected %d calls, got %d", testCase.expectedCalls, got)
+			}
+
+			if limit := testCase.timeout + time.Second; elapsed > limit {
+				t.Errorf("took %s, expected the %s timeout to be honored", elapsed, testCase.timeout)
+			}
+		})
+	}
+}
+
+func testAccSchemaImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["policy_store_id"], nil
+	}
+}
+
 func testAccCheckSchemaDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
@@ -191,3 +401,45 @@ resource "aws_verifiedpermissions_schema" "test" {
   }
 }`, namespace)
 }
+
+func testAccSchemaConfig_definition(value string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = "Terraform acceptance test"
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = "%[1]s"
+  }
+}`, value)
+}
+
+func testAccSchemaConfig_timeouts(namespace string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = "Terraform acceptance test"
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = "{\"%[1]s\":{\"actions\":{},\"entityTypes\":{}}}"
+  }
+
+  timeouts {
+    create = "10m"
+    update = "10m"
+    delete = "10m"
+  }
+}`, namespace)
+}
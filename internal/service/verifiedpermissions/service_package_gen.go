@@ -16,6 +16,14 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceBatchAuthorization,
+			Name:    "Batch Authorization",
+		},
+		{
+			Factory: newDataSourcePolicy,
+			Name:    "Policy",
+		},
 		{
 			Factory: newDataSourcePolicyStore,
 			Name:    "Policy Store",
@@ -77,6 +77,8 @@ func resourceByteMatchSet() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: fieldToMatchCustomizeDiff("byte_match_tuples"),
 	}
 }
 
@@ -136,6 +138,7 @@ func resourceByteMatchSetUpdate(ctx context.Context, d *schema.ResourceData, met
 	if d.HasChange("byte_match_tuples") {
 		o, n := d.GetChange("byte_match_tuples")
 		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+		diags = appendFieldToMatchWarnings(diags, "byte_match_tuples", tuplesFieldsToMatch(newT))
 		if err := updateByteMatchSet(ctx, conn, region, d.Id(), oldT, newT); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
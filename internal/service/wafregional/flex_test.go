@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafregional
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafregional/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var flexCmpOpts = []cmp.Option{
+	cmpopts.IgnoreUnexported(
+		awstypes.ActivatedRule{},
+		awstypes.FieldToMatch{},
+		awstypes.WafAction{},
+		awstypes.WafOverrideAction{},
+	),
+}
+
+func TestExpandAction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []interface{}
+		expected *awstypes.WafAction
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			input:    []interface{}{},
+			expected: nil,
+		},
+		{
+			name:     "nil element",
+			input:    []interface{}{nil},
+			expected: nil,
+		},
+		{
+			name:     "block",
+			input:    []interface{}{map[string]interface{}{names.AttrType: string(awstypes.WafActionTypeBlock)}},
+			expected: &awstypes.WafAction{Type: awstypes.WafActionTypeBlock},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandAction(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected, flexCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandWebACLUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		aclRule     map[string]interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			name: "regular",
+			aclRule: map[string]interface{}{
+				names.AttrAction:   []interface{}{map[string]interface{}{names.AttrType: string(awstypes.WafActionTypeBlock)}},
+				names.AttrPriority: 1,
+				"rule_id":          "rule-1",
+				names.AttrType:     string(awstypes.WafRuleTypeRegular),
+			},
+		},
+		{
+			name: "group",
+			aclRule: map[string]interface{}{
+				"override_action":  []interface{}{map[string]interface{}{names.AttrType: string(awstypes.WafOverrideActionTypeNone)}},
+				names.AttrPriority: 1,
+				"rule_id":          "rule-1",
+				names.AttrType:     string(awstypes.WafRuleTypeGroup),
+			},
+		},
+		{
+			name: "regular without action",
+			aclRule: map[string]interface{}{
+				names.AttrAction:   []interface{}{},
+				names.AttrPriority: 1,
+				"rule_id":          "rule-1",
+				names.AttrType:     string(awstypes.WafRuleTypeRegular),
+			},
+			expectedErr: regexache.MustCompile(`rule \(rule-1\) of type REGULAR requires an action`),
+		},
+		{
+			name: "regular with nil action element",
+			aclRule: map[string]interface{}{
+				names.AttrAction:   []interface{}{nil},
+				names.AttrPriority: 1,
+				"rule_id":          "rule-1",
+				names.AttrType:     string(awstypes.WafRuleTypeRegular),
+			},
+			expectedErr: regexache.MustCompile(`rule \(rule-1\) of type REGULAR requires an action`),
+		},
+		{
+			name: "rate based without action",
+			aclRule: map[string]interface{}{
+				names.AttrAction:   []interface{}{},
+				names.AttrPriority: 1,
+				"rule_id":          "rule-1",
+				names.AttrType:     string(awstypes.WafRuleTypeRateBased),
+			},
+			expectedErr: regexache.MustCompile(`rule \(rule-1\) of type RATE_BASED requires an action`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			update, err := expandWebACLUpdate(string(awstypes.ChangeActionInsert), testCase.aclRule)
+
+			if testCase.expectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErr)
+				}
+				if !testCase.expectedErr.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got %q", testCase.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := update.ActivatedRule.Type, awstypes.WafRuleType(testCase.aclRule[names.AttrType].(string)); got != want {
+				t.Errorf("rule type = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// The round-trip tests check that each expand/flatten pair is symmetric:
+// flattening an API object and expanding the result must produce the original
+// object. Asymmetric pairs cause perpetual diffs.
+
+func TestActionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		apiObject *awstypes.WafAction
+	}{
+		{
+			name:      "nil",
+			apiObject: nil,
+		},
+		{
+			name:      "allow",
+			apiObject: &awstypes.WafAction{Type: awstypes.WafActionTypeAllow},
+		},
+		{
+			name:      "block",
+			apiObject: &awstypes.WafAction{Type: awstypes.WafActionTypeBlock},
+		},
+		{
+			name:      "count",
+			apiObject: &awstypes.WafAction{Type: awstypes.WafActionTypeCount},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandAction(flattenAction(testCase.apiObject))
+
+			if diff := cmp.Diff(got, testCase.apiObject, flexCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOverrideActionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		apiObject *awstypes.WafOverrideAction
+	}{
+		{
+			name:      "nil",
+			apiObject: nil,
+		},
+		{
+			name:      "none",
+			apiObject: &awstypes.WafOverrideAction{Type: awstypes.WafOverrideActionTypeNone},
+		},
+		{
+			name:      "count",
+			apiObject: &awstypes.WafOverrideAction{Type: awstypes.WafOverrideActionTypeCount},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandOverrideAction(flattenOverrideAction(testCase.apiObject))
+
+			if diff := cmp.Diff(got, testCase.apiObject, flexCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFieldToMatchRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		apiObject *awstypes.FieldToMatch
+	}{
+		{
+			name:      "type only",
+			apiObject: &awstypes.FieldToMatch{Type: awstypes.MatchFieldTypeUri},
+		},
+		{
+			name: "with data",
+			apiObject: &awstypes.FieldToMatch{
+				Data: aws.String("referer"),
+				Type: awstypes.MatchFieldTypeHeader,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			flattened := flattenFieldToMatch(testCase.apiObject)
+			if len(flattened) != 1 {
+				t.Fatalf("expected 1 flattened element, got %d", len(flattened))
+			}
+
+			got := expandFieldToMatch(flattened[0].(map[string]interface{}))
+
+			if diff := cmp.Diff(got, testCase.apiObject, flexCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWebACLRuleRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		apiObject awstypes.ActivatedRule
+	}{
+		{
+			name: "regular",
+			apiObject: awstypes.ActivatedRule{
+				Action:   &awstypes.WafAction{Type: awstypes.WafActionTypeBlock},
+				Priority: aws.Int32(1),
+				RuleId:   aws.String("rule-1"),
+				Type:     awstypes.WafRuleTypeRegular,
+			},
+		},
+		{
+			name: "rate based",
+			apiObject: awstypes.ActivatedRule{
+				Action:   &awstypes.WafAction{Type: awstypes.WafActionTypeCount},
+				Priority: aws.Int32(2),
+				RuleId:   aws.String("rule-2"),
+				Type:     awstypes.WafRuleTypeRateBased,
+			},
+		},
+		{
+			name: "group with override_action",
+			apiObject: awstypes.ActivatedRule{
+				OverrideAction: &awstypes.WafOverrideAction{Type: awstypes.WafOverrideActionTypeNone},
+				Priority:       aws.Int32(3),
+				RuleId:         aws.String("rule-group-1"),
+				Type:           awstypes.WafRuleTypeGroup,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			flattened := flattenWebACLRules([]awstypes.ActivatedRule{testCase.apiObject})
+			if len(flattened) != 1 {
+				t.Fatalf("expected 1 flattened element, got %d", len(flattened))
+			}
+
+			update, err := expandWebACLUpdate(string(awstypes.ChangeActionInsert), flattened[0])
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(update.ActivatedRule, &testCase.apiObject, flexCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
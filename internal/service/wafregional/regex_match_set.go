@@ -80,6 +80,8 @@ func resourceRegexMatchSet() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: fieldToMatchCustomizeDiff("regex_match_tuple"),
 	}
 }
 
@@ -139,6 +141,7 @@ func resourceRegexMatchSetUpdate(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChange("regex_match_tuple") {
 		o, n := d.GetChange("regex_match_tuple")
 		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+		diags = appendFieldToMatchWarnings(diags, "regex_match_tuple", tuplesFieldsToMatch(newT))
 		if err := updateRegexMatchSet(ctx, conn, region, d.Id(), oldT, newT); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
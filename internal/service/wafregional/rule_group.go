@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -15,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -50,15 +53,17 @@ func resourceRuleGroup() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrType: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.WafActionType](),
 									},
 								},
 							},
 						},
 						names.AttrPriority: {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, math.MaxInt32),
 						},
 						"rule_id": {
 							Type:     schema.TypeString,
@@ -327,11 +332,8 @@ func expandActivatedRule(rule map[string]interface{}) *awstypes.ActivatedRule {
 		Type:     awstypes.WafRuleType(rule[names.AttrType].(string)),
 	}
 
-	if a, ok := rule[names.AttrAction].([]interface{}); ok && len(a) > 0 {
-		m := a[0].(map[string]interface{})
-		r.Action = &awstypes.WafAction{
-			Type: awstypes.WafActionType(m[names.AttrType].(string)),
-		}
+	if a, ok := rule[names.AttrAction].([]interface{}); ok {
+		r.Action = expandAction(a)
 	}
 	return r
 }
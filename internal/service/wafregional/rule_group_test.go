@@ -280,6 +280,25 @@ func TestAccWAFRegionalRuleGroup_noActivatedRules(t *testing.T) {
 	})
 }
 
+func TestAccWAFRegionalRuleGroup_invalidActionType(t *testing.T) {
+	ctx := acctest.Context(t)
+	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.WAFRegionalEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFRegionalServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_actionType(ruleName, groupName, "DENY"),
+				ExpectError: regexache.MustCompile(`expected type to be one of`),
+			},
+		},
+	})
+}
+
 func testAccCheckRuleGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -350,6 +369,29 @@ resource "aws_wafregional_rule_group" "test" {
 `, ruleName, groupName)
 }
 
+func testAccRuleGroupConfig_actionType(ruleName, groupName, actionType string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule" "test" {
+  name        = %[1]q
+  metric_name = %[1]q
+}
+
+resource "aws_wafregional_rule_group" "test" {
+  name        = %[2]q
+  metric_name = %[2]q
+
+  activated_rule {
+    action {
+      type = %[3]q
+    }
+
+    priority = 50
+    rule_id  = aws_wafregional_rule.test.id
+  }
+}
+`, ruleName, groupName, actionType)
+}
+
 func testAccRuleGroupConfig_tags1(ruleName, groupName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_wafregional_rule" "test" {
@@ -81,6 +81,8 @@ func resourceSizeConstraintSet() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: fieldToMatchCustomizeDiff("size_constraints"),
 	}
 }
 
@@ -140,6 +142,7 @@ func resourceSizeConstraintSetUpdate(ctx context.Context, d *schema.ResourceData
 	if d.HasChange("size_constraints") {
 		o, n := d.GetChange("size_constraints")
 		oldConstraints, newConstraints := o.(*schema.Set).List(), n.(*schema.Set).List()
+		diags = appendFieldToMatchWarnings(diags, "size_constraints", tuplesFieldsToMatch(newConstraints))
 		if err := updateSizeConstraintSet(ctx, conn, region, d.Id(), oldConstraints, newConstraints); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
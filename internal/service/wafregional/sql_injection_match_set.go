@@ -77,6 +77,8 @@ func resourceSQLInjectionMatchSet() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: fieldToMatchCustomizeDiff("sql_injection_match_tuple"),
 	}
 }
 
@@ -136,6 +138,7 @@ func resourceSQLInjectionMatchSetUpdate(ctx context.Context, d *schema.ResourceD
 	if d.HasChange("sql_injection_match_tuple") {
 		o, n := d.GetChange("sql_injection_match_tuple")
 		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+		diags = appendFieldToMatchWarnings(diags, "sql_injection_match_tuple", tuplesFieldsToMatch(newT))
 		if err := updateSQLInjectionMatchSet(ctx, conn, region, d.Id(), oldT, newT); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -4,10 +4,16 @@
 package wafregional
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
 	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafregional/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func validMetricName(v interface{}, k string) (ws []string, errors []error) {
@@ -20,6 +26,119 @@ func validMetricName(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validFieldToMatch verifies that `data` is set when the field type requires it.
+// `HEADER` and `SINGLE_QUERY_ARG` name the header or query argument to inspect.
+func validFieldToMatch(m map[string]interface{}) error {
+	fieldType, _ := m[names.AttrType].(string)
+	data, _ := m["data"].(string)
+
+	if fieldTypeRequiresData(fieldType) && data == "" {
+		return fmt.Errorf("field_to_match: data is required when type is %q", fieldType)
+	}
+
+	return nil
+}
+
+func fieldTypeRequiresData(fieldType string) bool {
+	switch awstypes.MatchFieldType(fieldType) {
+	case awstypes.MatchFieldTypeHeader, awstypes.MatchFieldTypeSingleQueryArg:
+		return true
+	default:
+		return false
+	}
+}
+
+func validFieldsToMatch(key string, fieldsToMatch []interface{}) error {
+	for _, v := range fieldsToMatch {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if err := validFieldToMatch(m); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// appendFieldToMatchWarnings warns about `data` set on field types that ignore it.
+// Such configurations have always been accepted, so they are not rejected at plan time.
+func appendFieldToMatchWarnings(diags diag.Diagnostics, key string, fieldsToMatch []interface{}) diag.Diagnostics {
+	for _, v := range fieldsToMatch {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		fieldType, _ := m[names.AttrType].(string)
+		data, _ := m["data"].(string)
+
+		if fieldType != "" && data != "" && !fieldTypeRequiresData(fieldType) {
+			diags = sdkdiag.AppendWarningf(diags, "%s: field_to_match: data is ignored when type is %q", key, fieldType)
+		}
+	}
+
+	return diags
+}
+
+// tuplesFieldsToMatch returns the `field_to_match` blocks nested in a list of match tuples.
+func tuplesFieldsToMatch(tuples []interface{}) []interface{} {
+	var fieldsToMatch []interface{}
+
+	for _, tfMapRaw := range tuples {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["field_to_match"].([]interface{}); ok {
+			fieldsToMatch = append(fieldsToMatch, v...)
+		}
+	}
+
+	return fieldsToMatch
+}
+
+// redactedFieldsToMatch returns the `field_to_match` blocks of a Web ACL's `logging_configuration.redacted_fields`.
+func redactedFieldsToMatch(loggingConfiguration []interface{}) []interface{} {
+	if len(loggingConfiguration) == 0 || loggingConfiguration[0] == nil {
+		return nil
+	}
+
+	redactedFields, ok := loggingConfiguration[0].(map[string]interface{})["redacted_fields"].([]interface{})
+	if !ok || len(redactedFields) == 0 || redactedFields[0] == nil {
+		return nil
+	}
+
+	if v, ok := redactedFields[0].(map[string]interface{})["field_to_match"].(*schema.Set); ok {
+		return v.List()
+	}
+
+	return nil
+}
+
+// fieldToMatchCustomizeDiff validates each `field_to_match` block nested in the named set of tuples at plan time.
+func fieldToMatchCustomizeDiff(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+
+		return validFieldsToMatch(key, tuplesFieldsToMatch(d.Get(key).(*schema.Set).List()))
+	}
+}
+
+// redactedFieldsCustomizeDiff validates each `field_to_match` block in a Web ACL's redacted fields at plan time.
+func redactedFieldsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrLoggingConfiguration) {
+		return nil
+	}
+
+	return validFieldsToMatch("logging_configuration.0.redacted_fields.0.field_to_match", redactedFieldsToMatch(d.Get(names.AttrLoggingConfiguration).([]interface{})))
+}
+
 func sliceContainsMap(l []interface{}, m map[string]interface{}) (int, bool) {
 	for i, t := range l {
 		if reflect.DeepEqual(m, t.(map[string]interface{})) {
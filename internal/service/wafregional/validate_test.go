@@ -4,8 +4,13 @@
 package wafregional
 
 import (
+	"context"
+	"math"
+	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -41,3 +46,285 @@ func TestValidMetricName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFieldToMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    map[string]interface{}
+		expectOK bool
+	}{
+		{
+			name: "HEADER with data",
+			input: map[string]interface{}{
+				"data":         "referer",
+				names.AttrType: "HEADER",
+			},
+			expectOK: true,
+		},
+		{
+			name: "HEADER without data",
+			input: map[string]interface{}{
+				"data":         "",
+				names.AttrType: "HEADER",
+			},
+		},
+		{
+			name: "SINGLE_QUERY_ARG without data",
+			input: map[string]interface{}{
+				names.AttrType: "SINGLE_QUERY_ARG",
+			},
+		},
+		{
+			name: "URI without data",
+			input: map[string]interface{}{
+				"data":         "",
+				names.AttrType: "URI",
+			},
+			expectOK: true,
+		},
+		{
+			name: "URI with data",
+			input: map[string]interface{}{
+				"data":         "referer",
+				names.AttrType: "URI",
+			},
+			expectOK: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validFieldToMatch(testCase.input)
+
+			if testCase.expectOK && err != nil {
+				t.Errorf("expected %v to be valid, got: %s", testCase.input, err)
+			}
+			if !testCase.expectOK && err == nil {
+				t.Errorf("expected %v to be invalid", testCase.input)
+			}
+		})
+	}
+}
+
+func TestAppendFieldToMatchWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		input        []interface{}
+		wantWarnings int
+	}{
+		{
+			name: "HEADER with data",
+			input: []interface{}{
+				map[string]interface{}{
+					"data":         "referer",
+					names.AttrType: "HEADER",
+				},
+			},
+		},
+		{
+			name: "URI without data",
+			input: []interface{}{
+				map[string]interface{}{
+					"data":         "",
+					names.AttrType: "URI",
+				},
+			},
+		},
+		{
+			name: "URI and BODY with data",
+			input: []interface{}{
+				map[string]interface{}{
+					"data":         "referer",
+					names.AttrType: "URI",
+				},
+				map[string]interface{}{
+					"data":         "referer",
+					names.AttrType: "BODY",
+				},
+			},
+			wantWarnings: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := appendFieldToMatchWarnings(nil, "byte_match_tuples", testCase.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := len(diags), testCase.wantWarnings; got != want {
+				t.Errorf("got %d warnings, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFieldToMatchCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	byteMatchSetConfig := func(fieldToMatch map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrName: "test",
+			"byte_match_tuples": []interface{}{
+				map[string]interface{}{
+					"field_to_match":        []interface{}{fieldToMatch},
+					"positional_constraint": "CONTAINS",
+					"target_string":         "badrefer1",
+					"text_transformation":   "NONE",
+				},
+			},
+		}
+	}
+	webACLConfig := func(fieldToMatch map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrName:       "test",
+			names.AttrMetricName: "test",
+			names.AttrDefaultAction: []interface{}{
+				map[string]interface{}{
+					names.AttrType: "ALLOW",
+				},
+			},
+			names.AttrLoggingConfiguration: []interface{}{
+				map[string]interface{}{
+					"log_destination": "arn:aws:firehose:us-west-2:123456789012:deliverystream/aws-waf-logs-test", //lintignore:AWSAT003,AWSAT005
+					"redacted_fields": []interface{}{
+						map[string]interface{}{
+							"field_to_match": []interface{}{fieldToMatch},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// Only the CustomizeDiff under test, as the Web ACL's tagging CustomizeDiff needs a configured provider.
+	webACL := resourceWebACL()
+	webACL.CustomizeDiff = redactedFieldsCustomizeDiff
+
+	testCases := []struct {
+		name     string
+		resource *schema.Resource
+		config   map[string]interface{}
+		expectOK bool
+	}{
+		{
+			name:     "byte match set HEADER with data",
+			resource: resourceByteMatchSet(),
+			config:   byteMatchSetConfig(map[string]interface{}{"data": "referer", names.AttrType: "HEADER"}),
+			expectOK: true,
+		},
+		{
+			name:     "byte match set HEADER without data",
+			resource: resourceByteMatchSet(),
+			config:   byteMatchSetConfig(map[string]interface{}{names.AttrType: "HEADER"}),
+		},
+		{
+			name:     "byte match set URI with data",
+			resource: resourceByteMatchSet(),
+			config:   byteMatchSetConfig(map[string]interface{}{"data": "referer", names.AttrType: "URI"}),
+			expectOK: true,
+		},
+		{
+			name:     "web ACL redacted HEADER with data",
+			resource: webACL,
+			config:   webACLConfig(map[string]interface{}{"data": "referer", names.AttrType: "HEADER"}),
+			expectOK: true,
+		},
+		{
+			name:     "web ACL redacted SINGLE_QUERY_ARG without data",
+			resource: webACL,
+			config:   webACLConfig(map[string]interface{}{names.AttrType: "SINGLE_QUERY_ARG"}),
+		},
+		{
+			name:     "web ACL redacted URI with data",
+			resource: webACL,
+			config:   webACLConfig(map[string]interface{}{"data": "referer", names.AttrType: "URI"}),
+			expectOK: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := testCase.resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(testCase.config), nil)
+
+			if testCase.expectOK && err != nil {
+				t.Errorf("expected no error, got: %s", err)
+			}
+			if !testCase.expectOK && err == nil {
+				t.Error("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestRulePriorityValidation(t *testing.T) {
+	t.Parallel()
+
+	schemas := map[string]*schema.Schema{
+		"aws_wafregional_web_acl rule":              resourceWebACL().SchemaMap()[names.AttrRule].Elem.(*schema.Resource).SchemaMap()[names.AttrPriority],
+		"aws_wafregional_rule_group activated_rule": resourceRuleGroup().SchemaMap()["activated_rule"].Elem.(*schema.Resource).SchemaMap()[names.AttrPriority],
+	}
+
+	// Not a constant, so that the out-of-range value below compiles on 32-bit platforms.
+	maxInt32 := math.MaxInt32
+
+	testCases := []struct {
+		name     string
+		input    int
+		expectOK bool
+	}{
+		{
+			name:     "zero",
+			input:    0,
+			expectOK: true,
+		},
+		{
+			name:     "max",
+			input:    maxInt32,
+			expectOK: true,
+		},
+		{
+			name:  "negative",
+			input: -1,
+		},
+	}
+
+	if strconv.IntSize == 64 {
+		testCases = append(testCases, struct {
+			name     string
+			input    int
+			expectOK bool
+		}{
+			name:  "out of range",
+			input: maxInt32 + 1,
+		})
+	}
+
+	for name, v := range schemas {
+		for _, testCase := range testCases {
+			t.Run(name+" "+testCase.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, errs := v.ValidateFunc(testCase.input, names.AttrPriority)
+
+				if got, want := len(errs) == 0, testCase.expectOK; got != want {
+					t.Errorf("valid = %t, want %t (errors: %v)", got, want, errs)
+				}
+			})
+		}
+	}
+}
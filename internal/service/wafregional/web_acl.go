@@ -5,15 +5,19 @@ package wafregional
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/wafregional"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafregional/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -140,8 +144,9 @@ func resourceWebACL() *schema.Resource {
 							},
 						},
 						names.AttrPriority: {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, math.MaxInt32),
 						},
 						names.AttrType: {
 							Type:             schema.TypeString,
@@ -160,7 +165,10 @@ func resourceWebACL() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			redactedFieldsCustomizeDiff,
+		),
 	}
 }
 
@@ -197,6 +205,8 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 			Resource:  "webacl/" + d.Id(),
 		}.String()
 
+		diags = appendFieldToMatchWarnings(diags, "logging_configuration.0.redacted_fields.0.field_to_match", redactedFieldsToMatch(loggingConfiguration))
+
 		input := &wafregional.PutLoggingConfigurationInput{
 			LoggingConfiguration: expandLoggingConfiguration(loggingConfiguration, arn),
 		}
@@ -209,11 +219,17 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if rules := d.Get(names.AttrRule).(*schema.Set).List(); len(rules) > 0 {
-		_, err := newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
+		updates, err := diffWebACLRules([]interface{}{}, rules)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WAF Regional Web ACL (%s) rules: %s", d.Id(), err)
+		}
+
+		_, err = newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
 			input := &wafregional.UpdateWebACLInput{
 				ChangeToken:   token,
 				DefaultAction: expandAction(d.Get(names.AttrDefaultAction).([]interface{})),
-				Updates:       diffWebACLRules([]interface{}{}, rules),
+				Updates:       updates,
 				WebACLId:      aws.String(d.Id()),
 			}
 
@@ -291,12 +307,17 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	if d.HasChanges(names.AttrDefaultAction, names.AttrRule) {
 		o, n := d.GetChange(names.AttrRule)
 		oldR, newR := o.(*schema.Set).List(), n.(*schema.Set).List()
+		updates, err := diffWebACLRules(oldR, newR)
 
-		_, err := newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WAF Regional Web ACL (%s) rules: %s", d.Id(), err)
+		}
+
+		_, err = newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
 			input := &wafregional.UpdateWebACLInput{
 				ChangeToken:   token,
 				DefaultAction: expandAction(d.Get(names.AttrDefaultAction).([]interface{})),
-				Updates:       diffWebACLRules(oldR, newR),
+				Updates:       updates,
 				WebACLId:      aws.String(d.Id()),
 			}
 
@@ -310,6 +331,8 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChange(names.AttrLoggingConfiguration) {
 		if loggingConfiguration := d.Get(names.AttrLoggingConfiguration).([]interface{}); len(loggingConfiguration) == 1 {
+			diags = appendFieldToMatchWarnings(diags, "logging_configuration.0.redacted_fields.0.field_to_match", redactedFieldsToMatch(loggingConfiguration))
+
 			input := &wafregional.PutLoggingConfigurationInput{
 				LoggingConfiguration: expandLoggingConfiguration(loggingConfiguration, d.Get(names.AttrARN).(string)),
 			}
@@ -341,11 +364,17 @@ func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	region := meta.(*conns.AWSClient).Region
 
 	if rules := d.Get(names.AttrRule).(*schema.Set).List(); len(rules) > 0 {
-		_, err := newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
+		updates, err := diffWebACLRules(rules, []interface{}{})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WAF Regional Web ACL (%s) rules: %s", d.Id(), err)
+		}
+
+		_, err = newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
 			input := &wafregional.UpdateWebACLInput{
 				ChangeToken:   token,
 				DefaultAction: expandAction(d.Get(names.AttrDefaultAction).([]interface{})),
-				Updates:       diffWebACLRules(rules, []interface{}{}),
+				Updates:       updates,
 				WebACLId:      aws.String(d.Id()),
 			}
 
@@ -492,7 +521,7 @@ func flattenRedactedFields(fieldToMatches []awstypes.FieldToMatch) []interface{}
 	return []interface{}{m}
 }
 
-func diffWebACLRules(oldR, newR []interface{}) []awstypes.WebACLUpdate {
+func diffWebACLRules(oldR, newR []interface{}) ([]awstypes.WebACLUpdate, error) {
 	updates := make([]awstypes.WebACLUpdate, 0)
 
 	for _, or := range oldR {
@@ -502,16 +531,26 @@ func diffWebACLRules(oldR, newR []interface{}) []awstypes.WebACLUpdate {
 			newR = append(newR[:idx], newR[idx+1:]...)
 			continue
 		}
-		updates = append(updates, expandWebACLUpdate(string(awstypes.ChangeActionDelete), aclRule))
+		update, err := expandWebACLUpdate(string(awstypes.ChangeActionDelete), aclRule)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
 	}
 
 	for _, nr := range newR {
 		aclRule := nr.(map[string]interface{})
-		updates = append(updates, expandWebACLUpdate(string(awstypes.ChangeActionInsert), aclRule))
+		update, err := expandWebACLUpdate(string(awstypes.ChangeActionInsert), aclRule)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
 	}
-	return updates
+	return updates, nil
 }
 
+// expandAction returns nil for an empty or unset action block; callers that
+// require an action must check for nil rather than rely on a default.
 func expandAction(l []interface{}) *awstypes.WafAction {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -536,23 +575,33 @@ func expandOverrideAction(l []interface{}) *awstypes.WafOverrideAction {
 	}
 }
 
-func expandWebACLUpdate(updateAction string, aclRule map[string]interface{}) awstypes.WebACLUpdate {
+func expandWebACLUpdate(updateAction string, aclRule map[string]interface{}) (awstypes.WebACLUpdate, error) {
 	var rule *awstypes.ActivatedRule
 
-	switch aclRule[names.AttrType].(string) {
+	ruleType := aclRule[names.AttrType].(string)
+
+	priority := aclRule[names.AttrPriority].(int)
+
+	switch ruleType {
 	case string(awstypes.WafRuleTypeGroup):
 		rule = &awstypes.ActivatedRule{
 			OverrideAction: expandOverrideAction(aclRule["override_action"].([]interface{})),
-			Priority:       aws.Int32(int32(aclRule[names.AttrPriority].(int))),
+			Priority:       aws.Int32(int32(priority)),
 			RuleId:         aws.String(aclRule["rule_id"].(string)),
-			Type:           awstypes.WafRuleType(aclRule[names.AttrType].(string)),
+			Type:           awstypes.WafRuleType(ruleType),
 		}
 	default:
+		// The API requires an action for REGULAR and RATE_BASED rules.
+		action := expandAction(aclRule[names.AttrAction].([]interface{}))
+		if action == nil {
+			return awstypes.WebACLUpdate{}, fmt.Errorf("rule (%s) of type %s requires an action", aclRule["rule_id"].(string), ruleType)
+		}
+
 		rule = &awstypes.ActivatedRule{
-			Action:   expandAction(aclRule[names.AttrAction].([]interface{})),
-			Priority: aws.Int32(int32(aclRule[names.AttrPriority].(int))),
+			Action:   action,
+			Priority: aws.Int32(int32(priority)),
 			RuleId:   aws.String(aclRule["rule_id"].(string)),
-			Type:     awstypes.WafRuleType(aclRule[names.AttrType].(string)),
+			Type:     awstypes.WafRuleType(ruleType),
 		}
 	}
 
@@ -561,10 +610,10 @@ func expandWebACLUpdate(updateAction string, aclRule map[string]interface{}) aws
 		ActivatedRule: rule,
 	}
 
-	return update
+	return update, nil
 }
 
-func flattenAction(n *awstypes.WafAction) []map[string]interface{} {
+func flattenAction(n *awstypes.WafAction) []interface{} {
 	if n == nil {
 		return nil
 	}
@@ -573,7 +622,19 @@ func flattenAction(n *awstypes.WafAction) []map[string]interface{} {
 		names.AttrType: string(n.Type),
 	}
 
-	return []map[string]interface{}{result}
+	return []interface{}{result}
+}
+
+func flattenOverrideAction(n *awstypes.WafOverrideAction) []interface{} {
+	if n == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		names.AttrType: string(n.Type),
+	}
+
+	return []interface{}{result}
 }
 
 func flattenWebACLRules(ts []awstypes.ActivatedRule) []map[string]interface{} {
@@ -583,18 +644,12 @@ func flattenWebACLRules(ts []awstypes.ActivatedRule) []map[string]interface{} {
 
 		switch r.Type {
 		case awstypes.WafRuleTypeGroup:
-			actionMap := map[string]interface{}{
-				names.AttrType: r.OverrideAction.Type,
-			}
-			m["override_action"] = []map[string]interface{}{actionMap}
+			m["override_action"] = flattenOverrideAction(r.OverrideAction)
 		default:
-			actionMap := map[string]interface{}{
-				names.AttrType: r.Action.Type,
-			}
-			m[names.AttrAction] = []map[string]interface{}{actionMap}
+			m[names.AttrAction] = flattenAction(r.Action)
 		}
 
-		m[names.AttrPriority] = r.Priority
+		m[names.AttrPriority] = int(aws.ToInt32(r.Priority))
 		m["rule_id"] = aws.ToString(r.RuleId)
 		m[names.AttrType] = string(r.Type)
 		out[i] = m
@@ -356,6 +356,24 @@ func TestAccWAFRegionalWebACL_changeRules(t *testing.T) {
 	})
 }
 
+func TestAccWAFRegionalWebACL_invalidRuleType(t *testing.T) {
+	ctx := acctest.Context(t)
+	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.WAFRegionalEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFRegionalServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebACLConfig_ruleType(wafAclName, "INVALID"),
+				ExpectError: regexache.MustCompile(`expected type to be one of`),
+			},
+		},
+	})
+}
+
 func TestAccWAFRegionalWebACL_logging(t *testing.T) {
 	ctx := acctest.Context(t)
 	var webACL1, webACL2, webACL3 awstypes.WebACL
@@ -499,6 +517,34 @@ resource "aws_wafregional_web_acl" "test" {
 `, name)
 }
 
+func testAccWebACLConfig_ruleType(name, ruleType string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule" "test" {
+  name        = %[1]q
+  metric_name = %[1]q
+}
+
+resource "aws_wafregional_web_acl" "test" {
+  name        = %[1]q
+  metric_name = %[1]q
+
+  default_action {
+    type = "ALLOW"
+  }
+
+  rule {
+    action {
+      type = "BLOCK"
+    }
+
+    priority = 1
+    rule_id  = aws_wafregional_rule.test.id
+    type     = %[2]q
+  }
+}
+`, name, ruleType)
+}
+
 func testAccWebACLConfig_tags1(name, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_wafregional_rule" "test" {
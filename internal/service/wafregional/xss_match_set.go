@@ -72,6 +72,8 @@ func resourceXSSMatchSet() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: fieldToMatchCustomizeDiff("xss_match_tuple"),
 	}
 }
 
@@ -97,6 +99,8 @@ func resourceXSSMatchSetCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(aws.ToString(output.(*wafregional.CreateXssMatchSetOutput).XssMatchSet.XssMatchSetId))
 
 	if v, ok := d.Get("xss_match_tuple").(*schema.Set); ok && v.Len() > 0 {
+		diags = appendFieldToMatchWarnings(diags, "xss_match_tuple", tuplesFieldsToMatch(v.List()))
+
 		if err := updateXSSMatchSet(ctx, conn, region, d.Id(), nil, v.List()); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -137,6 +141,7 @@ func resourceXSSMatchSetUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("xss_match_tuple") {
 		o, n := d.GetChange("xss_match_tuple")
 		oldT, newT := o.(*schema.Set).List(), n.(*schema.Set).List()
+		diags = appendFieldToMatchWarnings(diags, "xss_match_tuple", tuplesFieldsToMatch(newT))
 		if err := updateXSSMatchSet(ctx, conn, region, d.Id(), oldT, newT); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}